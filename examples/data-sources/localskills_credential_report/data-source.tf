@@ -0,0 +1,24 @@
+# Tokens nobody has used for 90 days
+data "localskills_credential_report" "stale" {
+  tenant_ids      = [localskills_team.engineering.id]
+  unused_for_days = 90
+}
+
+# Tokens that expire within the next two weeks
+data "localskills_credential_report" "expiring" {
+  tenant_ids           = [localskills_team.engineering.id]
+  expiring_within_days = 14
+}
+
+# Fail the nightly plan while stale or expiring tokens remain
+check "credential_hygiene" {
+  assert {
+    condition     = length(data.localskills_credential_report.stale.entries) == 0
+    error_message = "Unused tokens: ${join(", ", [for e in data.localskills_credential_report.stale.entries : "${e.type}/${e.name}"])}"
+  }
+
+  assert {
+    condition     = length(data.localskills_credential_report.expiring.entries) == 0
+    error_message = "Tokens expiring soon: ${join(", ", [for e in data.localskills_credential_report.expiring.entries : "${e.type}/${e.name} (${e.expires_at})"])}"
+  }
+}
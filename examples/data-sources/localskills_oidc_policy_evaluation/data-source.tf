@@ -0,0 +1,26 @@
+# Would a release job on a tag of the skills repository be trusted, and with
+# which skills?
+data "localskills_oidc_policy_evaluation" "release" {
+  tenant_id     = localskills_team.engineering.id
+  oidc_provider = "github"
+
+  claims = {
+    repository = "myorg/skills-repo"
+    ref        = "refs/tags/v1.4.0"
+    actor      = "octocat"
+  }
+}
+
+output "release_policy_results" {
+  value = {
+    for p in data.localskills_oidc_policy_evaluation.release.policies :
+    p.name => p.matched ? "matches" : join("; ", p.reasons)
+  }
+}
+
+check "release_pipeline_trusted" {
+  assert {
+    condition     = contains(data.localskills_oidc_policy_evaluation.release.skill_ids, localskills_skill.eslint_rules.id)
+    error_message = "No OIDC trust policy lets release jobs publish ${localskills_skill.eslint_rules.name}."
+  }
+}
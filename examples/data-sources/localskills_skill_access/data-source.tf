@@ -0,0 +1,20 @@
+# Which CI pipelines can fetch the skill?
+data "localskills_skill_access" "eslint_rules" {
+  skill_id = localskills_skill.eslint_rules.id
+}
+
+output "eslint_rules_pipelines" {
+  value = [
+    for p in data.localskills_skill_access.eslint_rules.policies :
+    "${p.oidc_provider}:${p.repository}@${p.ref_filter}"
+  ]
+}
+
+# Fail the plan while pipelines still depend on the skill, before it is
+# deleted or made private.
+check "eslint_rules_unused" {
+  assert {
+    condition     = length(data.localskills_skill_access.eslint_rules.policies) == 0 && length(data.localskills_skill_access.eslint_rules.skipped_tenant_ids) == 0
+    error_message = "${localskills_skill.eslint_rules.name} is still granted by: ${join(", ", [for p in data.localskills_skill_access.eslint_rules.policies : p.name])}."
+  }
+}
@@ -10,3 +10,8 @@ output "team_name" {
 output "my_role" {
   value = data.localskills_team.engineering.role
 }
+
+# Look up a team by ID
+data "localskills_team" "platform" {
+  id = "tnt_abc123"
+}
@@ -0,0 +1,8 @@
+# List the members of a team
+data "localskills_team_members" "engineering" {
+  tenant_id = localskills_team.engineering.id
+}
+
+output "admin_emails" {
+  value = [for m in data.localskills_team_members.engineering.members : m.email if m.role == "admin"]
+}
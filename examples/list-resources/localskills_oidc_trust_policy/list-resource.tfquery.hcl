@@ -0,0 +1,7 @@
+list "localskills_oidc_trust_policy" "engineering" {
+  provider = localskills
+
+  config {
+    tenant_id = "<team_id>"
+  }
+}
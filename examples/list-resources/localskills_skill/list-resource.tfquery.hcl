@@ -0,0 +1,8 @@
+list "localskills_skill" "team" {
+  provider = localskills
+
+  config {
+    tenant_id = "<team_id>"
+    type      = "skill"
+  }
+}
@@ -0,0 +1,7 @@
+list "localskills_team_invitation" "engineering" {
+  provider = localskills
+
+  config {
+    tenant_id = "<team_id>"
+  }
+}
@@ -1,4 +1,6 @@
 provider "localskills" {
   # base_url  = "https://localskills.sh"  # Optional, defaults to production
   # api_token = "lsk_..."                  # Or set LOCALSKILLS_API_TOKEN env var
+  # max_token_lifetime_days = 90           # Optional, caps token resource lifetimes
+  # token_expiry_warning_days = 30         # Optional, defaults to 14
 }
@@ -19,3 +19,41 @@ resource "localskills_oidc_trust_policy" "github_staging" {
   environment_filter = "staging"
   enabled            = true
 }
+
+# Trust a self-hosted issuer, but only for release workflows not started by bots
+resource "localskills_oidc_trust_policy" "custom_release" {
+  tenant_id     = localskills_team.engineering.id
+  name          = "Internal CI Release"
+  oidc_provider = "custom"
+  issuer_url    = "https://ci.example.com/oidc"
+  audience      = "localskills"
+  repository    = "platform/skills"
+  ref_filter    = "refs/tags/v*"
+
+  claim_conditions = [
+    {
+      claim    = "workflow"
+      operator = "in"
+      values   = ["release"]
+    },
+    {
+      claim    = "actor"
+      operator = "not_in"
+      values   = ["dependabot[bot]", "renovate[bot]"]
+    },
+  ]
+}
+
+# Grant every CI rule of the team, including ones added later
+resource "localskills_oidc_trust_policy" "github_ci_rules" {
+  tenant_id     = localskills_team.engineering.id
+  name          = "GitHub CI Rules"
+  oidc_provider = "github"
+  repository    = "myorg/app"
+  ref_filter    = "refs/heads/*"
+
+  skill_selector = {
+    tags = ["ci"]
+    type = "rule"
+  }
+}
@@ -2,6 +2,15 @@ resource "localskills_scim_token" "okta_provisioning" {
   tenant_id       = localskills_team.engineering.id
   name            = "Okta SCIM Provisioning"
   expires_in_days = 365
+
+  # Replace the token a month before it expires so SCIM sync never breaks
+  rotation = {
+    rotate_before_expiry_days = 30
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
 }
 
 output "scim_token" {
@@ -3,6 +3,15 @@ resource "localskills_team" "engineering" {
   description = "Platform engineering team"
 }
 
+# A team restricted to private skills and company email addresses
+resource "localskills_team" "security" {
+  name                  = "Security"
+  default_visibility    = "private"
+  allowed_visibilities  = ["private", "unlisted"]
+  allow_public_skills   = false
+  allowed_email_domains = ["example.com"]
+}
+
 output "team_slug" {
   value = localskills_team.engineering.slug
 }
@@ -8,4 +8,7 @@ resource "localskills_team_invitation" "bob_admin" {
   tenant_id = localskills_team.engineering.id
   email     = "bob@example.com"
   role      = "admin"
+
+  # Bump to email the invitation again
+  resend_trigger = "1"
 }
@@ -0,0 +1,14 @@
+# Invite a whole team at once
+resource "localskills_team_invitations" "engineering" {
+  tenant_id = localskills_team.engineering.id
+
+  invitations = {
+    "alice@example.com" = "admin"
+    "bob@example.com"   = "member"
+    "carol@example.com" = "viewonly"
+  }
+}
+
+output "invitation_status" {
+  value = localskills_team_invitations.engineering.status
+}
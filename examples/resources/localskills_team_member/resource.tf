@@ -0,0 +1,6 @@
+# Promote an existing member of the team to admin
+resource "localskills_team_member" "alice" {
+  tenant_id = localskills_team.engineering.id
+  email     = "alice@example.com"
+  role      = "admin"
+}
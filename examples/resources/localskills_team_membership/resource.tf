@@ -0,0 +1,33 @@
+# Terraform is the single source of truth for who belongs to the team:
+# missing users are invited and anyone not listed (other than owners) is removed
+resource "localskills_team_membership" "engineering" {
+  tenant_id = localskills_team.engineering.id
+
+  members = [
+    {
+      email = "alice@example.com"
+      role  = "admin"
+    },
+    {
+      email = "bob@example.com"
+      role  = "member"
+    },
+  ]
+}
+
+# Only report members that are not listed, without removing them
+resource "localskills_team_membership" "audited" {
+  tenant_id = localskills_team.audited.id
+  mode      = "report"
+
+  members = [
+    {
+      email = "carol@example.com"
+      role  = "viewonly"
+    },
+  ]
+}
+
+output "unlisted_members" {
+  value = localskills_team_membership.audited.unmanaged_members
+}
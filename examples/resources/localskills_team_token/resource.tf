@@ -2,6 +2,18 @@ resource "localskills_team_token" "ci" {
   tenant_id       = localskills_team.engineering.id
   name            = "CI Pipeline Token"
   expires_in_days = 90
+
+  # Only allow the pipeline to publish the skills it builds
+  scopes    = ["skills:read", "skills:publish"]
+  skill_ids = [localskills_skill.deploy_guide.id]
+
+  rotation = {
+    rotate_before_expiry_days = 14
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
 }
 
 # Store the token securely -- it is only available at creation
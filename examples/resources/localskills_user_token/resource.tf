@@ -1,3 +1,13 @@
 resource "localskills_user_token" "personal" {
-  name = "Development Token"
+  name            = "Development Token"
+  expires_in_days = 180
+
+  # Replace the token every quarter
+  rotation = {
+    rotate_after_days = 90
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
 }
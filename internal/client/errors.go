@@ -29,3 +29,11 @@ func IsUnauthorized(err error) bool {
 	}
 	return false
 }
+
+func IsConflict(err error) bool {
+	var apiErr *ApiError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == 409
+	}
+	return false
+}
@@ -19,3 +19,48 @@ func (c *Client) ListInvitations(ctx context.Context, tenantID string) ([]Tenant
 	}
 	return *result, nil
 }
+
+// GetInvitation fetches a single invitation, falling back to scanning
+// ListInvitations when the server has no single-invitation route.
+func (c *Client) GetInvitation(ctx context.Context, tenantID, invitationID string) (*TenantInvitation, error) {
+	path := fmt.Sprintf("/api/tenants/%s/invitations/%s", tenantID, invitationID)
+	invitation, err := DoJSON[TenantInvitation](c, ctx, http.MethodGet, path, nil)
+	if err == nil || !IsNotFound(err) {
+		return invitation, err
+	}
+
+	invitations, listErr := c.ListInvitations(ctx, tenantID)
+	if listErr != nil {
+		return nil, listErr
+	}
+	for i := range invitations {
+		if invitations[i].ID == invitationID {
+			return &invitations[i], nil
+		}
+	}
+	return nil, err
+}
+
+// RevokeInvitation cancels an invitation that has not been accepted yet.
+func (c *Client) RevokeInvitation(ctx context.Context, tenantID, invitationID string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/tenants/%s/invitations/%s", tenantID, invitationID), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return &ApiError{
+			StatusCode: resp.StatusCode,
+			Message:    fmt.Sprintf("failed to revoke invitation %s", invitationID),
+		}
+	}
+	return nil
+}
+
+// ResendInvitation emails the invitation again. The server issues a new token
+// and pushes out the expiry.
+func (c *Client) ResendInvitation(ctx context.Context, tenantID, invitationID string) (*TenantInvitation, error) {
+	path := fmt.Sprintf("/api/tenants/%s/invitations/%s/resend", tenantID, invitationID)
+	return DoJSON[TenantInvitation](c, ctx, http.MethodPost, path, nil)
+}
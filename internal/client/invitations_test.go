@@ -185,3 +185,70 @@ func TestListInvitations_NotFound(t *testing.T) {
 		t.Error("expected IsNotFound to return true")
 	}
 }
+
+func TestGetInvitation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/tenants/tenant-1/invitations/inv-1" {
+			t.Errorf("expected /api/tenants/tenant-1/invitations/inv-1, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ApiResponse[TenantInvitation]{
+			Success: true,
+			Data:    TenantInvitation{ID: "inv-1", TenantID: "tenant-1", Email: "dev@example.com"},
+		})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "lsk_test123")
+	inv, err := c.GetInvitation(context.Background(), "tenant-1", "inv-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if inv.Email != "dev@example.com" {
+		t.Errorf("expected email 'dev@example.com', got '%s'", inv.Email)
+	}
+}
+
+func TestRevokeInvitation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/tenants/tenant-1/invitations/inv-1" {
+			t.Errorf("expected /api/tenants/tenant-1/invitations/inv-1, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "lsk_test123")
+	if err := c.RevokeInvitation(context.Background(), "tenant-1", "inv-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestResendInvitation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/tenants/tenant-1/invitations/inv-1/resend" {
+			t.Errorf("expected /api/tenants/tenant-1/invitations/inv-1/resend, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ApiResponse[TenantInvitation]{
+			Success: true,
+			Data:    TenantInvitation{ID: "inv-1", TenantID: "tenant-1", ExpiresAt: "2024-01-15T00:00:00Z"},
+		})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "lsk_test123")
+	inv, err := c.ResendInvitation(context.Background(), "tenant-1", "inv-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if inv.ExpiresAt != "2024-01-15T00:00:00Z" {
+		t.Errorf("expected expires_at '2024-01-15T00:00:00Z', got '%s'", inv.ExpiresAt)
+	}
+}
@@ -0,0 +1,31 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) ListMembers(ctx context.Context, tenantID string) ([]TenantMember, error) {
+	members, err := DoJSON[[]TenantMember](c, ctx, http.MethodGet, fmt.Sprintf("/api/tenants/%s/members", tenantID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("listing team members: %w", err)
+	}
+	return *members, nil
+}
+
+func (c *Client) UpdateMember(ctx context.Context, tenantID, userID string, req UpdateMemberRequest) (*TenantMember, error) {
+	member, err := DoJSON[TenantMember](c, ctx, http.MethodPatch, fmt.Sprintf("/api/tenants/%s/members/%s", tenantID, userID), req)
+	if err != nil {
+		return nil, fmt.Errorf("updating team member: %w", err)
+	}
+	return member, nil
+}
+
+func (c *Client) RemoveMember(ctx context.Context, tenantID, userID string) error {
+	_, err := DoJSON[struct{}](c, ctx, http.MethodDelete, fmt.Sprintf("/api/tenants/%s/members/%s", tenantID, userID), nil)
+	if err != nil {
+		return fmt.Errorf("removing team member: %w", err)
+	}
+	return nil
+}
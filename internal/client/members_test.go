@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListMembers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/tenants/tenant-1/members" {
+			t.Errorf("expected /api/tenants/tenant-1/members, got %s", r.URL.Path)
+		}
+
+		resp := ApiResponse[[]TenantMember]{
+			Success: true,
+			Data: []TenantMember{
+				{UserID: "user-1", Email: "owner@example.com", Role: "owner", JoinedAt: "2024-01-01T00:00:00Z"},
+				{UserID: "user-2", Email: "dev@example.com", Role: "member", JoinedAt: "2024-02-01T00:00:00Z"},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "lsk_test123")
+	members, err := c.ListMembers(context.Background(), "tenant-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(members) != 2 {
+		t.Fatalf("expected 2 members, got %d", len(members))
+	}
+	if members[1].Email != "dev@example.com" {
+		t.Errorf("expected email 'dev@example.com', got '%s'", members[1].Email)
+	}
+}
+
+func TestUpdateMember(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected PATCH, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/tenants/tenant-1/members/user-2" {
+			t.Errorf("expected /api/tenants/tenant-1/members/user-2, got %s", r.URL.Path)
+		}
+
+		var reqBody UpdateMemberRequest
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+
+		resp := ApiResponse[TenantMember]{
+			Success: true,
+			Data:    TenantMember{UserID: "user-2", Email: "dev@example.com", Role: reqBody.Role},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "lsk_test123")
+	member, err := c.UpdateMember(context.Background(), "tenant-1", "user-2", UpdateMemberRequest{Role: "admin"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if member.Role != "admin" {
+		t.Errorf("expected role 'admin', got '%s'", member.Role)
+	}
+}
+
+func TestRemoveMember_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(ApiResponse[json.RawMessage]{Success: false, Error: "member not found"})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "lsk_test123")
+	err := c.RemoveMember(context.Background(), "tenant-1", "user-9")
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
@@ -92,6 +92,7 @@ type Tenant struct {
 	Description string `json:"description"`
 	CreatedAt   string `json:"createdAt"`
 	UpdatedAt   string `json:"updatedAt"`
+	TenantSettings
 }
 
 type TenantWithRole struct {
@@ -102,6 +103,16 @@ type TenantWithRole struct {
 	Role        string `json:"role"`
 	CreatedAt   string `json:"createdAt"`
 	UpdatedAt   string `json:"updatedAt"`
+	TenantSettings
+}
+
+// TenantSettings holds the team-level skill and invitation policy. Servers
+// that predate these settings omit them.
+type TenantSettings struct {
+	DefaultVisibility   string   `json:"defaultVisibility,omitempty"`
+	AllowedVisibilities []string `json:"allowedVisibilities,omitempty"`
+	AllowPublicSkills   *bool    `json:"allowPublicSkills,omitempty"`
+	AllowedEmailDomains []string `json:"allowedEmailDomains,omitempty"`
 }
 
 type CreateTenantRequest struct {
@@ -109,9 +120,13 @@ type CreateTenantRequest struct {
 }
 
 type UpdateTenantRequest struct {
-	Name        *string `json:"name,omitempty"`
-	Slug        *string `json:"slug,omitempty"`
-	Description *string `json:"description,omitempty"`
+	Name                *string   `json:"name,omitempty"`
+	Slug                *string   `json:"slug,omitempty"`
+	Description         *string   `json:"description,omitempty"`
+	DefaultVisibility   *string   `json:"defaultVisibility,omitempty"`
+	AllowedVisibilities *[]string `json:"allowedVisibilities,omitempty"`
+	AllowPublicSkills   *bool     `json:"allowPublicSkills,omitempty"`
+	AllowedEmailDomains *[]string `json:"allowedEmailDomains,omitempty"`
 }
 
 // --- Invitations ---
@@ -133,30 +148,53 @@ type CreateInvitationRequest struct {
 	Role  string `json:"role"`
 }
 
+// --- Members ---
+
+type TenantMember struct {
+	UserID   string  `json:"userId"`
+	Email    string  `json:"email"`
+	Name     *string `json:"name"`
+	Role     string  `json:"role"`
+	JoinedAt string  `json:"joinedAt"`
+}
+
+type UpdateMemberRequest struct {
+	Role string `json:"role"`
+}
+
 // --- API Tokens ---
 
+// TokenScopes are the scopes a user or team token can be limited to.
+var TokenScopes = []string{"skills:read", "skills:write", "skills:publish", "team:read", "team:admin"}
+
 type ApiToken struct {
-	ID         string  `json:"id"`
-	Name       string  `json:"name"`
-	LastUsedAt *string `json:"lastUsedAt"`
-	ExpiresAt  *string `json:"expiresAt"`
-	CreatedAt  string  `json:"createdAt"`
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	LastUsedAt *string  `json:"lastUsedAt"`
+	ExpiresAt  *string  `json:"expiresAt"`
+	CreatedAt  string   `json:"createdAt"`
+	Scopes     []string `json:"scopes,omitempty"`
+	SkillIDs   []string `json:"skillIds,omitempty"`
 }
 
 type ApiTokenWithSecret struct {
-	ID    string `json:"id"`
-	Name  string `json:"name"`
-	Token string `json:"token"`
+	ID        string  `json:"id"`
+	Name      string  `json:"name"`
+	Token     string  `json:"token"`
+	CreatedAt string  `json:"createdAt"`
+	ExpiresAt *string `json:"expiresAt"`
 }
 
 type TeamApiToken struct {
-	ID             string  `json:"id"`
-	Name           string  `json:"name"`
-	CreatedAt      string  `json:"createdAt"`
-	LastUsedAt     *string `json:"lastUsedAt"`
-	ExpiresAt      *string `json:"expiresAt"`
-	CreatedByName  *string `json:"createdByName"`
-	CreatedByEmail string  `json:"createdByEmail"`
+	ID             string   `json:"id"`
+	Name           string   `json:"name"`
+	CreatedAt      string   `json:"createdAt"`
+	LastUsedAt     *string  `json:"lastUsedAt"`
+	ExpiresAt      *string  `json:"expiresAt"`
+	CreatedByName  *string  `json:"createdByName"`
+	CreatedByEmail string   `json:"createdByEmail"`
+	Scopes         []string `json:"scopes,omitempty"`
+	SkillIDs       []string `json:"skillIds,omitempty"`
 }
 
 type TeamApiTokenWithSecret struct {
@@ -168,12 +206,18 @@ type TeamApiTokenWithSecret struct {
 }
 
 type CreateTokenRequest struct {
-	Name string `json:"name"`
+	Name          string   `json:"name"`
+	ExpiresInDays *int     `json:"expiresInDays,omitempty"`
+	ExpiresAt     *string  `json:"expiresAt,omitempty"`
+	Scopes        []string `json:"scopes,omitempty"`
+	SkillIDs      []string `json:"skillIds,omitempty"`
 }
 
 type CreateTeamTokenRequest struct {
-	Name          string `json:"name"`
-	ExpiresInDays *int   `json:"expiresInDays,omitempty"`
+	Name          string   `json:"name"`
+	ExpiresInDays *int     `json:"expiresInDays,omitempty"`
+	Scopes        []string `json:"scopes,omitempty"`
+	SkillIDs      []string `json:"skillIds,omitempty"`
 }
 
 // --- SCIM Tokens ---
@@ -201,39 +245,65 @@ type CreateScimTokenRequest struct {
 
 // --- OIDC Trust Policies ---
 
-type OidcTrustPolicy struct {
-	ID                string   `json:"id"`
-	TenantID          string   `json:"tenantId"`
-	Name              string   `json:"name"`
-	Provider          string   `json:"provider"`
-	Repository        string   `json:"repository"`
-	RefFilter         string   `json:"refFilter"`
-	EnvironmentFilter *string  `json:"environmentFilter"`
-	SkillIDs          []string `json:"skillIds"`
-	Enabled           bool     `json:"enabled"`
-	CreatedBy         string   `json:"createdBy"`
-	CreatedAt         string   `json:"createdAt"`
-	UpdatedAt         string   `json:"updatedAt"`
+// OidcProviders are the CI/CD systems whose OIDC tokens a trust policy can
+// accept. "custom" trusts any issuer given by issuerUrl.
+var OidcProviders = []string{"github", "gitlab", "buildkite", "circleci", "bitbucket", "custom"}
+
+// OidcClaimOperators are the comparisons a claim condition can make against
+// its values.
+var OidcClaimOperators = []string{"in", "not_in", "glob", "not_glob"}
+
+type OidcClaimCondition struct {
+	Claim    string   `json:"claim"`
+	Operator string   `json:"operator"`
+	Values   []string `json:"values"`
 }
 
-type CreateOidcPolicyRequest struct {
-	Name              string   `json:"name"`
-	Provider          string   `json:"provider"`
-	Repository        string   `json:"repository"`
-	RefFilter         string   `json:"refFilter,omitempty"`
-	EnvironmentFilter *string  `json:"environmentFilter,omitempty"`
-	SkillIDs          []string `json:"skillIds,omitempty"`
-	Enabled           bool     `json:"enabled"`
+type OidcTrustPolicy struct {
+	ID                string               `json:"id"`
+	TenantID          string               `json:"tenantId"`
+	Name              string               `json:"name"`
+	Provider          string               `json:"provider"`
+	IssuerURL         *string              `json:"issuerUrl"`
+	Audience          *string              `json:"audience"`
+	Repository        string               `json:"repository"`
+	RefFilter         string               `json:"refFilter"`
+	EnvironmentFilter *string              `json:"environmentFilter"`
+	ClaimConditions   []OidcClaimCondition `json:"claimConditions"`
+	SkillIDs          []string             `json:"skillIds"`
+	Enabled           bool                 `json:"enabled"`
+	CreatedBy         string               `json:"createdBy"`
+	CreatedAt         string               `json:"createdAt"`
+	UpdatedAt         string               `json:"updatedAt"`
 }
 
+type CreateOidcPolicyRequest struct {
+	Name              string               `json:"name"`
+	Provider          string               `json:"provider"`
+	IssuerURL         *string              `json:"issuerUrl,omitempty"`
+	Audience          *string              `json:"audience,omitempty"`
+	Repository        string               `json:"repository"`
+	RefFilter         string               `json:"refFilter,omitempty"`
+	EnvironmentFilter *string              `json:"environmentFilter,omitempty"`
+	ClaimConditions   []OidcClaimCondition `json:"claimConditions,omitempty"`
+	SkillIDs          []string             `json:"skillIds,omitempty"`
+	Enabled           bool                 `json:"enabled"`
+}
+
+// UpdateOidcPolicyRequest leaves nil fields unchanged. IssuerURL and Audience
+// point to an empty string to clear them, and ClaimConditions and SkillIDs to
+// an empty slice to remove every condition or skill.
 type UpdateOidcPolicyRequest struct {
-	Name              *string  `json:"name,omitempty"`
-	Provider          *string  `json:"provider,omitempty"`
-	Repository        *string  `json:"repository,omitempty"`
-	RefFilter         *string  `json:"refFilter,omitempty"`
-	EnvironmentFilter *string  `json:"environmentFilter,omitempty"`
-	SkillIDs          []string `json:"skillIds,omitempty"`
-	Enabled           *bool    `json:"enabled,omitempty"`
+	Name              *string               `json:"name,omitempty"`
+	Provider          *string               `json:"provider,omitempty"`
+	IssuerURL         *string               `json:"issuerUrl,omitempty"`
+	Audience          *string               `json:"audience,omitempty"`
+	Repository        *string               `json:"repository,omitempty"`
+	RefFilter         *string               `json:"refFilter,omitempty"`
+	EnvironmentFilter *string               `json:"environmentFilter,omitempty"`
+	ClaimConditions   *[]OidcClaimCondition `json:"claimConditions,omitempty"`
+	SkillIDs          *[]string             `json:"skillIds,omitempty"`
+	Enabled           *bool                 `json:"enabled,omitempty"`
 }
 
 // --- SSO ---
@@ -14,6 +14,29 @@ func (c *Client) ListOIDCPolicies(ctx context.Context, tenantID string) ([]OidcT
 	return *policies, nil
 }
 
+// GetOIDCPolicy fetches a single OIDC trust policy, falling back to scanning
+// ListOIDCPolicies when the server has no single-policy route.
+func (c *Client) GetOIDCPolicy(ctx context.Context, tenantID, policyID string) (*OidcTrustPolicy, error) {
+	policy, err := DoJSON[OidcTrustPolicy](c, ctx, http.MethodGet, fmt.Sprintf("/api/tenants/%s/oidc-policies/%s", tenantID, policyID), nil)
+	if err == nil {
+		return policy, nil
+	}
+	if !IsNotFound(err) {
+		return nil, fmt.Errorf("getting OIDC policy: %w", err)
+	}
+
+	policies, listErr := c.ListOIDCPolicies(ctx, tenantID)
+	if listErr != nil {
+		return nil, listErr
+	}
+	for i := range policies {
+		if policies[i].ID == policyID {
+			return &policies[i], nil
+		}
+	}
+	return nil, fmt.Errorf("getting OIDC policy: %w", err)
+}
+
 func (c *Client) CreateOIDCPolicy(ctx context.Context, tenantID string, req CreateOidcPolicyRequest) (*OidcTrustPolicy, error) {
 	policy, err := DoJSON[OidcTrustPolicy](c, ctx, http.MethodPost, fmt.Sprintf("/api/tenants/%s/oidc-policies", tenantID), req)
 	if err != nil {
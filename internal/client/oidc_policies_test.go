@@ -166,6 +166,38 @@ func TestUpdateOIDCPolicy(t *testing.T) {
 	}
 }
 
+func TestUpdateOIDCPolicy_claimConditions(t *testing.T) {
+	var body map[string]json.RawMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body = nil
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ApiResponse[OidcTrustPolicy]{Success: true, Data: OidcTrustPolicy{ID: "policy-1"}})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "lsk_test123")
+
+	// An empty slice removes the conditions rather than leaving them as is
+	if _, err := c.UpdateOIDCPolicy(context.Background(), "tenant-1", "policy-1", UpdateOidcPolicyRequest{
+		ClaimConditions: &[]OidcClaimCondition{},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(body["claimConditions"]) != "[]" {
+		t.Errorf("expected claimConditions [], got %s", body["claimConditions"])
+	}
+
+	if _, err := c.UpdateOIDCPolicy(context.Background(), "tenant-1", "policy-1", UpdateOidcPolicyRequest{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := body["claimConditions"]; ok {
+		t.Errorf("expected claimConditions to be omitted, got %s", body["claimConditions"])
+	}
+}
+
 func TestDeleteOIDCPolicy(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodDelete {
@@ -211,3 +243,24 @@ func TestDeleteOIDCPolicy_NotFound(t *testing.T) {
 		t.Error("expected IsNotFound to return true")
 	}
 }
+
+func TestGetOIDCPolicy_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/tenants/tenant-1/oidc-policies" {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(ApiResponse[[]OidcTrustPolicy]{
+				Success: true,
+				Data:    []OidcTrustPolicy{{ID: "pol-1"}},
+			})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "lsk_test123")
+	_, err := c.GetOIDCPolicy(context.Background(), "tenant-1", "pol-2")
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
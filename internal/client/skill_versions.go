@@ -19,6 +19,26 @@ func (c *Client) ListSkillVersions(ctx context.Context, skillID string) ([]Skill
 	return *result, nil
 }
 
+// GetSkillVersion fetches a single version of a skill, falling back to
+// scanning ListSkillVersions when the server has no single-version route.
+func (c *Client) GetSkillVersion(ctx context.Context, skillID string, version int) (*SkillVersion, error) {
+	result, err := DoJSON[SkillVersion](c, ctx, http.MethodGet, fmt.Sprintf("/api/skills/%s/versions/%d", skillID, version), nil)
+	if err == nil || !IsNotFound(err) {
+		return result, err
+	}
+
+	versions, listErr := c.ListSkillVersions(ctx, skillID)
+	if listErr != nil {
+		return nil, listErr
+	}
+	for i := range versions {
+		if versions[i].Version == version {
+			return &versions[i], nil
+		}
+	}
+	return nil, err
+}
+
 func (c *Client) GetSkillContent(ctx context.Context, skillID string, params map[string]string) (*SkillContent, error) {
 	path := fmt.Sprintf("/api/skills/%s/content", skillID)
 	if len(params) > 0 {
@@ -357,3 +357,30 @@ func TestExplore_NoParams(t *testing.T) {
 		t.Errorf("expected 0 skills, got %d", len(skills))
 	}
 }
+
+func TestGetSkillVersion_FallbackToList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/skills/skill-1/versions" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ApiResponse[[]SkillVersion]{
+			Success: true,
+			Data: []SkillVersion{
+				{ID: "v-1", SkillID: "skill-1", Version: 1, Semver: "1.0.0"},
+				{ID: "v-2", SkillID: "skill-1", Version: 2, Semver: "1.1.0"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "lsk_test123")
+	version, err := c.GetSkillVersion(context.Background(), "skill-1", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version.Semver != "1.1.0" {
+		t.Errorf("expected semver '1.1.0', got '%s'", version.Semver)
+	}
+}
@@ -85,6 +85,30 @@ func TestCreateSkill_Error(t *testing.T) {
 	}
 }
 
+func TestCreateSkill_Conflict(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(ApiResponse[json.RawMessage]{
+			Success: false,
+			Error:   "a skill with this slug already exists",
+		})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "lsk_test123")
+	_, err := c.CreateSkill(context.Background(), CreateSkillRequest{Name: "dup"})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !IsConflict(err) {
+		t.Error("expected IsConflict to return true")
+	}
+	if IsNotFound(err) {
+		t.Error("expected IsNotFound to return false")
+	}
+}
+
 func TestGetSkill(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
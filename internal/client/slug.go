@@ -0,0 +1,25 @@
+package client
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Slugify follows the platform's slug generation to find the slug a team or
+// skill created with name gets, which is what a create conflicts on.
+func Slugify(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
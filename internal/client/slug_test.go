@@ -0,0 +1,19 @@
+package client
+
+import "testing"
+
+func TestSlugify(t *testing.T) {
+	cases := map[string]string{
+		"Deploy Helper":        "deploy-helper",
+		"  Platform -- Team! ": "platform-team",
+		"ESLint_Rules v2":      "eslint-rules-v2",
+		"Équipe Données":       "équipe-données",
+		"---":                  "",
+	}
+
+	for name, want := range cases {
+		if got := Slugify(name); got != want {
+			t.Errorf("Slugify(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -22,3 +22,39 @@ func (c *Client) UpdateTenant(ctx context.Context, tenantID string, req UpdateTe
 	path := fmt.Sprintf("/api/tenants/%s", tenantID)
 	return DoJSON[Tenant](c, ctx, http.MethodPatch, path, req)
 }
+
+func (c *Client) DeleteTenant(ctx context.Context, tenantID string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/tenants/%s", tenantID), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return &ApiError{
+			StatusCode: resp.StatusCode,
+			Message:    fmt.Sprintf("failed to delete tenant %s", tenantID),
+		}
+	}
+	return nil
+}
+
+// GetTenant fetches a single tenant. Servers without the single-tenant route
+// answer 404, so a 404 falls back to scanning ListTenants.
+func (c *Client) GetTenant(ctx context.Context, tenantID string) (*TenantWithRole, error) {
+	tenant, err := DoJSON[TenantWithRole](c, ctx, http.MethodGet, fmt.Sprintf("/api/tenants/%s", tenantID), nil)
+	if err == nil || !IsNotFound(err) {
+		return tenant, err
+	}
+
+	tenants, listErr := c.ListTenants(ctx)
+	if listErr != nil {
+		return nil, listErr
+	}
+	for i := range tenants {
+		if tenants[i].ID == tenantID {
+			return &tenants[i], nil
+		}
+	}
+	return nil, err
+}
@@ -197,3 +197,140 @@ func TestCreateTenant_Error(t *testing.T) {
 		t.Errorf("expected status 400, got %d", apiErr.StatusCode)
 	}
 }
+
+func TestUpdateTenant_settings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if _, ok := body["name"]; ok {
+			t.Error("expected name to be omitted")
+		}
+		if body["defaultVisibility"] != "private" {
+			t.Errorf("expected defaultVisibility 'private', got %v", body["defaultVisibility"])
+		}
+		// An empty domain list lifts the restriction, so it must be sent
+		if domains, ok := body["allowedEmailDomains"].([]interface{}); !ok || len(domains) != 0 {
+			t.Errorf("expected an empty allowedEmailDomains list, got %v", body["allowedEmailDomains"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"success":true,"data":{"id":"tenant-1","defaultVisibility":"private","allowedVisibilities":["private","unlisted"],"allowPublicSkills":false,"allowedEmailDomains":[]}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "lsk_test123")
+	visibility := "private"
+	domains := []string{}
+	tenant, err := c.UpdateTenant(context.Background(), "tenant-1", UpdateTenantRequest{
+		DefaultVisibility:   &visibility,
+		AllowedEmailDomains: &domains,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tenant.DefaultVisibility != "private" {
+		t.Errorf("expected default visibility 'private', got '%s'", tenant.DefaultVisibility)
+	}
+	if len(tenant.AllowedVisibilities) != 2 {
+		t.Errorf("expected 2 allowed visibilities, got %v", tenant.AllowedVisibilities)
+	}
+	if tenant.AllowPublicSkills == nil || *tenant.AllowPublicSkills {
+		t.Errorf("expected allowPublicSkills false, got %v", tenant.AllowPublicSkills)
+	}
+}
+
+func TestDeleteTenant(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/tenants/tenant-1" {
+			t.Errorf("expected /api/tenants/tenant-1, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "lsk_test123")
+	if err := c.DeleteTenant(context.Background(), "tenant-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDeleteTenant_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "lsk_test123")
+	err := c.DeleteTenant(context.Background(), "nonexistent")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !IsNotFound(err) {
+		t.Error("expected IsNotFound to return true")
+	}
+}
+
+func TestGetTenant(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/tenants/tenant-1" {
+			t.Errorf("expected /api/tenants/tenant-1, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ApiResponse[TenantWithRole]{
+			Success: true,
+			Data:    TenantWithRole{ID: "tenant-1", Name: "Team One", Slug: "team-one", Role: "owner"},
+		})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "lsk_test123")
+	tenant, err := c.GetTenant(context.Background(), "tenant-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tenant.Slug != "team-one" {
+		t.Errorf("expected slug 'team-one', got '%s'", tenant.Slug)
+	}
+}
+
+func TestGetTenant_FallbackToList(t *testing.T) {
+	var listed bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/tenants" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		listed = true
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ApiResponse[[]TenantWithRole]{
+			Success: true,
+			Data: []TenantWithRole{
+				{ID: "tenant-1", Name: "Team One"},
+				{ID: "tenant-2", Name: "Team Two"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "lsk_test123")
+	tenant, err := c.GetTenant(context.Background(), "tenant-2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !listed {
+		t.Error("expected a fallback ListTenants request")
+	}
+	if tenant.Name != "Team Two" {
+		t.Errorf("expected name 'Team Two', got '%s'", tenant.Name)
+	}
+
+	_, err = c.GetTenant(context.Background(), "tenant-3")
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error for a missing tenant, got %v", err)
+	}
+}
@@ -38,6 +38,29 @@ func (c *Client) ListTeamTokens(ctx context.Context, tenantID string) ([]TeamApi
 	return *tokens, nil
 }
 
+// GetTeamToken fetches a single team token, falling back to scanning
+// ListTeamTokens when the server has no single-token route.
+func (c *Client) GetTeamToken(ctx context.Context, tenantID, tokenID string) (*TeamApiToken, error) {
+	token, err := DoJSON[TeamApiToken](c, ctx, http.MethodGet, fmt.Sprintf("/api/tenants/%s/tokens/%s", tenantID, tokenID), nil)
+	if err == nil {
+		return token, nil
+	}
+	if !IsNotFound(err) {
+		return nil, fmt.Errorf("getting team token: %w", err)
+	}
+
+	tokens, listErr := c.ListTeamTokens(ctx, tenantID)
+	if listErr != nil {
+		return nil, listErr
+	}
+	for i := range tokens {
+		if tokens[i].ID == tokenID {
+			return &tokens[i], nil
+		}
+	}
+	return nil, fmt.Errorf("getting team token: %w", err)
+}
+
 func (c *Client) CreateTeamToken(ctx context.Context, tenantID string, req CreateTeamTokenRequest) (*TeamApiTokenWithSecret, error) {
 	token, err := DoJSON[TeamApiTokenWithSecret](c, ctx, http.MethodPost, fmt.Sprintf("/api/tenants/%s/tokens", tenantID), req)
 	if err != nil {
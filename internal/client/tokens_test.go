@@ -90,6 +90,47 @@ func TestCreateUserToken(t *testing.T) {
 	}
 }
 
+func TestCreateUserToken_expiry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqBody CreateTokenRequest
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if reqBody.ExpiresInDays == nil || *reqBody.ExpiresInDays != 30 {
+			t.Errorf("expected expiresInDays 30, got %v", reqBody.ExpiresInDays)
+		}
+		if reqBody.ExpiresAt != nil {
+			t.Errorf("expected no expiresAt, got %s", *reqBody.ExpiresAt)
+		}
+
+		expiresAt := "2024-01-31T00:00:00Z"
+		resp := ApiResponse[ApiTokenWithSecret]{
+			Success: true,
+			Data: ApiTokenWithSecret{
+				ID:        "tok-new",
+				Name:      reqBody.Name,
+				Token:     "lsk_secret_value",
+				CreatedAt: "2024-01-01T00:00:00Z",
+				ExpiresAt: &expiresAt,
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "lsk_test123")
+	days := 30
+	token, err := c.CreateUserToken(context.Background(), CreateTokenRequest{Name: "test-token", ExpiresInDays: &days})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if token.ExpiresAt == nil || *token.ExpiresAt != "2024-01-31T00:00:00Z" {
+		t.Errorf("expected expiresAt '2024-01-31T00:00:00Z', got %v", token.ExpiresAt)
+	}
+}
+
 func TestDeleteUserToken(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodDelete {
@@ -241,3 +282,26 @@ func TestDeleteUserToken_NotFound(t *testing.T) {
 		t.Error("expected IsNotFound to return true")
 	}
 }
+
+func TestGetTeamToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/tenants/tenant-1/tokens/tok-1" {
+			t.Errorf("expected /api/tenants/tenant-1/tokens/tok-1, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ApiResponse[TeamApiToken]{
+			Success: true,
+			Data:    TeamApiToken{ID: "tok-1", Name: "ci"},
+		})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "lsk_test123")
+	token, err := c.GetTeamToken(context.Background(), "tenant-1", "tok-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token.Name != "ci" {
+		t.Errorf("expected name 'ci', got '%s'", token.Name)
+	}
+}
@@ -0,0 +1,315 @@
+package credential_report
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &CredentialReportDataSource{}
+	_ datasource.DataSourceWithConfigure = &CredentialReportDataSource{}
+)
+
+type CredentialReportDataSource struct {
+	client *client.Client
+}
+
+func NewDataSource() datasource.DataSource {
+	return &CredentialReportDataSource{}
+}
+
+// credential is a user, team or SCIM token as listed by the API.
+type credential struct {
+	kind       string
+	tenantID   string
+	id         string
+	name       string
+	createdAt  string
+	lastUsedAt *string
+	expiresAt  *string
+}
+
+func (d *CredentialReportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_report"
+}
+
+func (d *CredentialReportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reports the user, team and SCIM tokens visible to the authenticated user, optionally filtered to the stale or expiring ones.",
+		Attributes: map[string]schema.Attribute{
+			"tenant_ids": schema.SetAttribute{
+				Description: "The teams (tenants) whose team and SCIM tokens are included. Defaults to every team the authenticated user owns or administers.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"unused_for_days": schema.Int64Attribute{
+				Description: "Only include tokens not used for at least this many days. Tokens that were never used count from when they were created.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"expiring_within_days": schema.Int64Attribute{
+				Description: "Only include tokens that expire within this many days, including tokens that have already expired.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"never_used": schema.BoolAttribute{
+				Description: "If true, only include tokens that were never used. If false, only include tokens that were.",
+				Optional:    true,
+			},
+			"entries": schema.ListNestedAttribute{
+				Description: "The tokens matching every filter that is set, ordered by type, team and name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The kind of token: user, team or scim.",
+							Computed:    true,
+						},
+						"tenant_id": schema.StringAttribute{
+							Description: "The team (tenant) the token belongs to. Null for user tokens.",
+							Computed:    true,
+						},
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the token.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the token.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the token was created.",
+							Computed:    true,
+						},
+						"last_used_at": schema.StringAttribute{
+							Description: "When the token was last used.",
+							Computed:    true,
+						},
+						"expires_at": schema.StringAttribute{
+							Description: "When the token expires.",
+							Computed:    true,
+						},
+						"never_used": schema.BoolAttribute{
+							Description: "Whether the token has never been used.",
+							Computed:    true,
+						},
+						"expired": schema.BoolAttribute{
+							Description: "Whether the token has already expired.",
+							Computed:    true,
+						},
+						"days_since_last_use": schema.Int64Attribute{
+							Description: "Whole days since the token was last used, or since it was created if it was never used.",
+							Computed:    true,
+						},
+						"days_until_expiry": schema.Int64Attribute{
+							Description: "Whole days until the token expires, negative once it has expired. Null if the token does not expire.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CredentialReportDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.client = c
+}
+
+func (d *CredentialReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config CredentialReportModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tenantIDs []string
+	if !config.TenantIDs.IsNull() {
+		resp.Diagnostics.Append(config.TenantIDs.ElementsAs(ctx, &tenantIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		tenants, err := d.client.ListTenants(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading teams", err.Error())
+			return
+		}
+		for _, t := range tenants {
+			if t.Role == "owner" || t.Role == "admin" {
+				tenantIDs = append(tenantIDs, t.ID)
+			}
+		}
+	}
+
+	credentials, err := d.listCredentials(ctx, tenantIDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading tokens", err.Error())
+		return
+	}
+
+	now := time.Now()
+	config.Entries = []CredentialReportEntryModel{}
+	for _, cred := range credentials {
+		entry := reportEntry(ctx, cred, now)
+		if matches(config, entry, cred, now) {
+			config.Entries = append(config.Entries, entry)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// listCredentials collects the user tokens and the team and SCIM tokens of
+// each tenant, sorted by type, tenant and name.
+func (d *CredentialReportDataSource) listCredentials(ctx context.Context, tenantIDs []string) ([]credential, error) {
+	var credentials []credential
+
+	userTokens, err := d.client.ListUserTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range userTokens {
+		credentials = append(credentials, credential{kind: "user", id: t.ID, name: t.Name, createdAt: t.CreatedAt, lastUsedAt: t.LastUsedAt, expiresAt: t.ExpiresAt})
+	}
+
+	for _, tenantID := range tenantIDs {
+		teamTokens, err := d.client.ListTeamTokens(ctx, tenantID)
+		if err != nil {
+			return nil, fmt.Errorf("team %s: %w", tenantID, err)
+		}
+		for _, t := range teamTokens {
+			credentials = append(credentials, credential{kind: "team", tenantID: tenantID, id: t.ID, name: t.Name, createdAt: t.CreatedAt, lastUsedAt: t.LastUsedAt, expiresAt: t.ExpiresAt})
+		}
+
+		// Teams without SCIM provisioning have no SCIM tokens to report
+		scimTokens, err := d.client.ListSCIMTokens(ctx, tenantID)
+		if err != nil && !client.IsNotFound(err) {
+			return nil, fmt.Errorf("team %s: %w", tenantID, err)
+		}
+		for _, t := range scimTokens {
+			credentials = append(credentials, credential{kind: "scim", tenantID: tenantID, id: t.ID, name: t.Name, createdAt: t.CreatedAt, lastUsedAt: t.LastUsedAt, expiresAt: t.ExpiresAt})
+		}
+	}
+
+	sort.SliceStable(credentials, func(i, j int) bool {
+		a, b := credentials[i], credentials[j]
+		if a.kind != b.kind {
+			return a.kind < b.kind
+		}
+		if a.tenantID != b.tenantID {
+			return a.tenantID < b.tenantID
+		}
+		if a.name != b.name {
+			return a.name < b.name
+		}
+		return a.id < b.id
+	})
+	return credentials, nil
+}
+
+func reportEntry(ctx context.Context, cred credential, now time.Time) CredentialReportEntryModel {
+	entry := CredentialReportEntryModel{
+		Type:             types.StringValue(cred.kind),
+		TenantID:         types.StringNull(),
+		ID:               types.StringValue(cred.id),
+		Name:             types.StringValue(cred.name),
+		CreatedAt:        types.StringValue(cred.createdAt),
+		LastUsedAt:       types.StringNull(),
+		ExpiresAt:        types.StringNull(),
+		NeverUsed:        types.BoolValue(cred.lastUsedAt == nil),
+		Expired:          types.BoolValue(false),
+		DaysSinceLastUse: types.Int64Null(),
+		DaysUntilExpiry:  types.Int64Null(),
+	}
+	if cred.tenantID != "" {
+		entry.TenantID = types.StringValue(cred.tenantID)
+	}
+
+	lastUse := cred.createdAt
+	if cred.lastUsedAt != nil {
+		entry.LastUsedAt = types.StringValue(*cred.lastUsedAt)
+		lastUse = *cred.lastUsedAt
+	}
+	if t, ok := parseTime(ctx, cred, lastUse); ok {
+		entry.DaysSinceLastUse = types.Int64Value(wholeDays(now.Sub(t)))
+	}
+
+	if cred.expiresAt != nil {
+		entry.ExpiresAt = types.StringValue(*cred.expiresAt)
+		if t, ok := parseTime(ctx, cred, *cred.expiresAt); ok {
+			entry.Expired = types.BoolValue(!t.After(now))
+			entry.DaysUntilExpiry = types.Int64Value(wholeDays(t.Sub(now)))
+		}
+	}
+	return entry
+}
+
+// matches reports whether entry passes every filter set in config.
+func matches(config CredentialReportModel, entry CredentialReportEntryModel, cred credential, now time.Time) bool {
+	if !config.NeverUsed.IsNull() && entry.NeverUsed.ValueBool() != config.NeverUsed.ValueBool() {
+		return false
+	}
+	if !config.UnusedForDays.IsNull() {
+		if entry.DaysSinceLastUse.IsNull() || entry.DaysSinceLastUse.ValueInt64() < config.UnusedForDays.ValueInt64() {
+			return false
+		}
+	}
+	if !config.ExpiringWithinDays.IsNull() {
+		if cred.expiresAt == nil {
+			return false
+		}
+		expires, err := time.Parse(time.RFC3339, *cred.expiresAt)
+		if err != nil || expires.After(now.AddDate(0, 0, int(config.ExpiringWithinDays.ValueInt64()))) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseTime(ctx context.Context, cred credential, value string) (time.Time, bool) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		tflog.Warn(ctx, "Skipping unparseable token timestamp", map[string]interface{}{
+			"type":  cred.kind,
+			"id":    cred.id,
+			"value": value,
+		})
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// wholeDays returns d in whole days, rounded towards zero.
+func wholeDays(d time.Duration) int64 {
+	return int64(d / (24 * time.Hour))
+}
@@ -0,0 +1,161 @@
+package credential_report_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/datasources/credential_report"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
+)
+
+func TestAccCredentialReportDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "localskills_credential_report" "test" {
+  unused_for_days = 0
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.localskills_credential_report.test", "entries.#"),
+				),
+			},
+		},
+	})
+}
+
+func daysAgo(days int) string {
+	return time.Now().AddDate(0, 0, -days).Format(time.RFC3339)
+}
+
+// newReportServer serves one admin and one member team, with user, team and
+// SCIM tokens of varying age.
+func newReportServer(t *testing.T) *client.Client {
+	t.Helper()
+	server, mux := testutils.NewMockLocalskillsServer()
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/api/tenants", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "tenant-1", "name": "Platform", "role": "admin"},
+			{"id": "tenant-2", "name": "Docs", "role": "member"},
+		})
+	})
+	mux.HandleFunc("/api/user/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "user-fresh", "name": "laptop", "createdAt": daysAgo(200), "lastUsedAt": daysAgo(1)},
+			{"id": "user-stale", "name": "old laptop", "createdAt": daysAgo(200), "lastUsedAt": daysAgo(120)},
+		})
+	})
+	mux.HandleFunc("/api/tenants/tenant-1/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "team-unused", "name": "ci", "createdAt": daysAgo(100), "expiresAt": daysAgo(-5)},
+		})
+	})
+	mux.HandleFunc("/api/tenants/tenant-1/scim-tokens", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "scim-expired", "name": "okta", "createdAt": daysAgo(400), "lastUsedAt": daysAgo(2), "expiresAt": daysAgo(1)},
+		})
+	})
+	mux.HandleFunc("/api/tenants/tenant-2/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("expected the member team to be skipped, got %s", r.URL.Path)
+	})
+	return client.NewClient(server.URL, "lsk_test123")
+}
+
+func entryIDs(t *testing.T, attrs map[string]tftypes.Value) string {
+	t.Helper()
+	state, diags := testutils.ReadDataSource(t, credential_report.NewDataSource(), newReportServer(t), attrs)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+
+	var entries []credential_report.CredentialReportEntryModel
+	if diags := state.GetAttribute(context.Background(), path.Root("entries"), &entries); diags.HasError() {
+		t.Fatalf("unexpected errors reading entries: %s", diags)
+	}
+	ids := make([]string, len(entries))
+	for i, e := range entries {
+		ids[i] = e.ID.ValueString()
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestCredentialReportDataSource_filters(t *testing.T) {
+	cases := map[string]struct {
+		attrs map[string]tftypes.Value
+		want  string
+	}{
+		"no filters": {
+			want: "scim-expired,team-unused,user-fresh,user-stale",
+		},
+		"unused_for_days": {
+			attrs: map[string]tftypes.Value{"unused_for_days": tftypes.NewValue(tftypes.Number, 90)},
+			want:  "team-unused,user-stale",
+		},
+		"expiring_within_days": {
+			attrs: map[string]tftypes.Value{"expiring_within_days": tftypes.NewValue(tftypes.Number, 7)},
+			want:  "scim-expired,team-unused",
+		},
+		"never_used": {
+			attrs: map[string]tftypes.Value{"never_used": tftypes.NewValue(tftypes.Bool, true)},
+			want:  "team-unused",
+		},
+		"combined": {
+			attrs: map[string]tftypes.Value{
+				"never_used":      tftypes.NewValue(tftypes.Bool, false),
+				"unused_for_days": tftypes.NewValue(tftypes.Number, 30),
+			},
+			want: "user-stale",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := entryIDs(t, tc.attrs); got != tc.want {
+				t.Errorf("expected entries %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCredentialReportDataSource_entry(t *testing.T) {
+	state, diags := testutils.ReadDataSource(t, credential_report.NewDataSource(), newReportServer(t), map[string]tftypes.Value{
+		"tenant_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "tenant-1")}),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+
+	var entries []credential_report.CredentialReportEntryModel
+	state.GetAttribute(context.Background(), path.Root("entries"), &entries)
+	if len(entries) != 4 {
+		t.Fatalf("expected 4 entries, got %d", len(entries))
+	}
+
+	scim := entries[0]
+	if scim.Type.ValueString() != "scim" || scim.TenantID.ValueString() != "tenant-1" {
+		t.Errorf("unexpected first entry: %+v", scim)
+	}
+	if !scim.Expired.ValueBool() || scim.DaysUntilExpiry.ValueInt64() != -1 || scim.DaysSinceLastUse.ValueInt64() != 2 {
+		t.Errorf("unexpected expiry or usage: %+v", scim)
+	}
+
+	team := entries[1]
+	if !team.NeverUsed.ValueBool() || team.DaysSinceLastUse.ValueInt64() != 100 || team.Expired.ValueBool() {
+		t.Errorf("unexpected team entry: %+v", team)
+	}
+	if !entries[2].TenantID.IsNull() || !entries[2].DaysUntilExpiry.IsNull() {
+		t.Errorf("expected user tokens to have no team or expiry, got %+v", entries[2])
+	}
+}
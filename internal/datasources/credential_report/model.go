@@ -0,0 +1,27 @@
+package credential_report
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type CredentialReportModel struct {
+	TenantIDs          types.Set                    `tfsdk:"tenant_ids"`
+	UnusedForDays      types.Int64                  `tfsdk:"unused_for_days"`
+	ExpiringWithinDays types.Int64                  `tfsdk:"expiring_within_days"`
+	NeverUsed          types.Bool                   `tfsdk:"never_used"`
+	Entries            []CredentialReportEntryModel `tfsdk:"entries"`
+}
+
+type CredentialReportEntryModel struct {
+	Type             types.String `tfsdk:"type"`
+	TenantID         types.String `tfsdk:"tenant_id"`
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	LastUsedAt       types.String `tfsdk:"last_used_at"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+	NeverUsed        types.Bool   `tfsdk:"never_used"`
+	Expired          types.Bool   `tfsdk:"expired"`
+	DaysSinceLastUse types.Int64  `tfsdk:"days_since_last_use"`
+	DaysUntilExpiry  types.Int64  `tfsdk:"days_until_expiry"`
+}
@@ -0,0 +1,186 @@
+package oidc_policy_evaluation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/oidcpolicy"
+)
+
+var (
+	_ datasource.DataSource              = &OidcPolicyEvaluationDataSource{}
+	_ datasource.DataSourceWithConfigure = &OidcPolicyEvaluationDataSource{}
+)
+
+type OidcPolicyEvaluationDataSource struct {
+	client *client.Client
+}
+
+func NewDataSource() datasource.DataSource {
+	return &OidcPolicyEvaluationDataSource{}
+}
+
+func (d *OidcPolicyEvaluationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oidc_policy_evaluation"
+}
+
+func (d *OidcPolicyEvaluationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Evaluates the OIDC trust policies of a team against a sample set of OIDC token claims, without exchanging a token, and reports which policies match and which skills they grant.",
+		Attributes: map[string]schema.Attribute{
+			"tenant_id": schema.StringAttribute{
+				Description: "The tenant (team) ID whose policies are evaluated.",
+				Required:    true,
+			},
+			"oidc_provider": schema.StringAttribute{
+				Description: "The OIDC provider that issued the token. If set, policies for other providers do not match.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.OidcProviders...),
+				},
+			},
+			"claims": schema.MapAttribute{
+				Description: "The claims of the OIDC token. The repository, Git ref and deployment environment go under repository, ref and environment, whatever the CI provider calls them, and the issuer and audience under iss and aud; claim conditions look up claims by their own names.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"policies": schema.ListNestedAttribute{
+				Description: "Every policy of the team, in the order the API returns them, with the outcome of the evaluation.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the policy.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the policy.",
+							Computed:    true,
+						},
+						"oidc_provider": schema.StringAttribute{
+							Description: "The OIDC provider of the policy.",
+							Computed:    true,
+						},
+						"matched": schema.BoolAttribute{
+							Description: "Whether the policy matches the claims.",
+							Computed:    true,
+						},
+						"reasons": schema.ListAttribute{
+							Description: "Why the policy does not match. Empty when it does.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"matched_policy_ids": schema.ListAttribute{
+				Description: "The IDs of the matching policies.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"skill_ids": schema.SetAttribute{
+				Description: "The skills listed by the matching policies.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"all_skills": schema.BoolAttribute{
+				Description: "Whether a matching policy has no skill_ids and so grants every skill of the team.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *OidcPolicyEvaluationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.client = c
+}
+
+func (d *OidcPolicyEvaluationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config OidcPolicyEvaluationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	claims := oidcpolicy.Claims{}
+	resp.Diagnostics.Append(config.Claims.ElementsAs(ctx, &claims, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies, err := d.client.ListOIDCPolicies(ctx, config.TenantID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading OIDC trust policies", err.Error())
+		return
+	}
+
+	matchedIDs := []string{}
+	granted := map[string]bool{}
+	allSkills := false
+	config.Policies = make([]PolicyEvaluationModel, len(policies))
+	for i, p := range policies {
+		reasons := []string{}
+		if !config.Provider.IsNull() && p.Provider != config.Provider.ValueString() {
+			reasons = append(reasons, fmt.Sprintf("the policy is for %s, not %s", p.Provider, config.Provider.ValueString()))
+		}
+		reasons = append(reasons, oidcpolicy.Evaluate(p, claims)...)
+
+		reasonList, diags := types.ListValueFrom(ctx, types.StringType, reasons)
+		resp.Diagnostics.Append(diags...)
+		config.Policies[i] = PolicyEvaluationModel{
+			ID:       types.StringValue(p.ID),
+			Name:     types.StringValue(p.Name),
+			Provider: types.StringValue(p.Provider),
+			Matched:  types.BoolValue(len(reasons) == 0),
+			Reasons:  reasonList,
+		}
+		if len(reasons) > 0 {
+			continue
+		}
+
+		matchedIDs = append(matchedIDs, p.ID)
+		if len(p.SkillIDs) == 0 {
+			allSkills = true
+		}
+		for _, id := range p.SkillIDs {
+			granted[id] = true
+		}
+	}
+
+	skillIDs := make([]string, 0, len(granted))
+	for id := range granted {
+		skillIDs = append(skillIDs, id)
+	}
+	sort.Strings(skillIDs)
+
+	var diags diag.Diagnostics
+	config.MatchedPolicyIDs, diags = types.ListValueFrom(ctx, types.StringType, matchedIDs)
+	resp.Diagnostics.Append(diags...)
+	config.SkillIDs, diags = types.SetValueFrom(ctx, types.StringType, skillIDs)
+	resp.Diagnostics.Append(diags...)
+	config.AllSkills = types.BoolValue(allSkills)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
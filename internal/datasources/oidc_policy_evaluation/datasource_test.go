@@ -0,0 +1,98 @@
+package oidc_policy_evaluation_test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/datasources/oidc_policy_evaluation"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
+)
+
+func TestAccOidcPolicyEvaluationDataSource_basic(t *testing.T) {
+	tenantID := os.Getenv("LOCALSKILLS_TENANT_ID")
+	if tenantID == "" {
+		t.Skip("LOCALSKILLS_TENANT_ID must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "localskills_oidc_policy_evaluation" "test" {
+  tenant_id = "` + tenantID + `"
+  claims = {
+    repository = "myorg/myrepo"
+    ref        = "refs/heads/main"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.localskills_oidc_policy_evaluation.test", "policies.#"),
+					resource.TestCheckResourceAttrSet("data.localskills_oidc_policy_evaluation.test", "all_skills"),
+				),
+			},
+		},
+	})
+}
+
+func TestOidcPolicyEvaluationDataSource_read(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/tenants/tenant-1/oidc-policies", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "pol-main", "name": "main", "provider": "github", "repository": "org/repo", "refFilter": "refs/heads/main", "skillIds": []string{"sk-2", "sk-1"}, "enabled": true},
+			{"id": "pol-tags", "name": "tags", "provider": "github", "repository": "org/repo", "refFilter": "refs/tags/*", "skillIds": []string{"sk-3"}, "enabled": true},
+			{"id": "pol-any", "name": "any ref", "provider": "github", "repository": "org/repo", "refFilter": "*", "skillIds": []string{"sk-1"}, "enabled": true},
+			{"id": "pol-gitlab", "name": "gitlab", "provider": "gitlab", "repository": "org/repo", "refFilter": "*", "enabled": true},
+		})
+	})
+
+	state, diags := testutils.ReadDataSource(t, oidc_policy_evaluation.NewDataSource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"tenant_id":     tftypes.NewValue(tftypes.String, "tenant-1"),
+		"oidc_provider": tftypes.NewValue(tftypes.String, "github"),
+		"claims": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"repository": tftypes.NewValue(tftypes.String, "org/repo"),
+			"ref":        tftypes.NewValue(tftypes.String, "refs/heads/main"),
+		}),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+
+	ctx := context.Background()
+	var matched, skillIDs []string
+	state.GetAttribute(ctx, path.Root("matched_policy_ids"), &matched)
+	state.GetAttribute(ctx, path.Root("skill_ids"), &skillIDs)
+	if len(matched) != 2 || matched[0] != "pol-main" || matched[1] != "pol-any" {
+		t.Errorf("expected pol-main and pol-any to match, got %v", matched)
+	}
+	if len(skillIDs) != 2 || skillIDs[0] != "sk-1" || skillIDs[1] != "sk-2" {
+		t.Errorf("expected skills sk-1 and sk-2, got %v", skillIDs)
+	}
+
+	var allSkills types.Bool
+	state.GetAttribute(ctx, path.Root("all_skills"), &allSkills)
+	if allSkills.ValueBool() {
+		t.Error("expected all_skills to be false, as the gitlab policy is for another provider")
+	}
+
+	var reasons []string
+	state.GetAttribute(ctx, path.Root("policies").AtListIndex(1).AtName("reasons"), &reasons)
+	if len(reasons) != 1 || reasons[0] != `ref "refs/heads/main" does not match ref_filter "refs/tags/*"` {
+		t.Errorf("unexpected reasons for the tags policy: %q", reasons)
+	}
+	state.GetAttribute(ctx, path.Root("policies").AtListIndex(3).AtName("reasons"), &reasons)
+	if len(reasons) != 1 || reasons[0] != "the policy is for gitlab, not github" {
+		t.Errorf("unexpected reasons for the gitlab policy: %q", reasons)
+	}
+}
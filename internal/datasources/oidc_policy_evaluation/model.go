@@ -0,0 +1,23 @@
+package oidc_policy_evaluation
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type OidcPolicyEvaluationModel struct {
+	TenantID         types.String            `tfsdk:"tenant_id"`
+	Provider         types.String            `tfsdk:"oidc_provider"`
+	Claims           types.Map               `tfsdk:"claims"`
+	Policies         []PolicyEvaluationModel `tfsdk:"policies"`
+	MatchedPolicyIDs types.List              `tfsdk:"matched_policy_ids"`
+	SkillIDs         types.Set               `tfsdk:"skill_ids"`
+	AllSkills        types.Bool              `tfsdk:"all_skills"`
+}
+
+type PolicyEvaluationModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Provider types.String `tfsdk:"oidc_provider"`
+	Matched  types.Bool   `tfsdk:"matched"`
+	Reasons  types.List   `tfsdk:"reasons"`
+}
@@ -46,7 +46,15 @@ func (d *oidcTrustPoliciesDataSource) Schema(_ context.Context, _ datasource.Sch
 							Computed:    true,
 						},
 						"oidc_provider": schema.StringAttribute{
-							Description: "The OIDC provider (github or gitlab).",
+							Description: "The OIDC provider: github, gitlab, buildkite, circleci, bitbucket or custom.",
+							Computed:    true,
+						},
+						"issuer_url": schema.StringAttribute{
+							Description: "The issuer URL of a custom OIDC provider.",
+							Computed:    true,
+						},
+						"audience": schema.StringAttribute{
+							Description: "The audience expected from a custom OIDC provider.",
 							Computed:    true,
 						},
 						"repository": schema.StringAttribute{
@@ -61,6 +69,27 @@ func (d *oidcTrustPoliciesDataSource) Schema(_ context.Context, _ datasource.Sch
 							Description: "Environment filter.",
 							Computed:    true,
 						},
+						"claim_conditions": schema.ListNestedAttribute{
+							Description: "Further conditions on the claims of the OIDC token.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"claim": schema.StringAttribute{
+										Description: "The name of the claim.",
+										Computed:    true,
+									},
+									"operator": schema.StringAttribute{
+										Description: "How the claim is compared with values: in, not_in, glob or not_glob.",
+										Computed:    true,
+									},
+									"values": schema.ListAttribute{
+										Description: "The values or patterns the claim is compared with.",
+										Computed:    true,
+										ElementType: types.StringType,
+									},
+								},
+							},
+						},
 						"enabled": schema.BoolAttribute{
 							Description: "Whether the policy is enabled.",
 							Computed:    true,
@@ -120,6 +149,8 @@ func (d *oidcTrustPoliciesDataSource) Read(ctx context.Context, req datasource.R
 			ID:         types.StringValue(p.ID),
 			Name:       types.StringValue(p.Name),
 			Provider:   types.StringValue(p.Provider),
+			IssuerURL:  types.StringPointerValue(p.IssuerURL),
+			Audience:   types.StringPointerValue(p.Audience),
 			Repository: types.StringValue(p.Repository),
 			RefFilter:  types.StringValue(p.RefFilter),
 			Enabled:    types.BoolValue(p.Enabled),
@@ -127,6 +158,16 @@ func (d *oidcTrustPoliciesDataSource) Read(ctx context.Context, req datasource.R
 			CreatedAt:  types.StringValue(p.CreatedAt),
 			UpdatedAt:  types.StringValue(p.UpdatedAt),
 		}
+		state.Policies[i].ClaimConditions = make([]OidcClaimConditionModel, len(p.ClaimConditions))
+		for j, c := range p.ClaimConditions {
+			values, diags := types.ListValueFrom(ctx, types.StringType, c.Values)
+			resp.Diagnostics.Append(diags...)
+			state.Policies[i].ClaimConditions[j] = OidcClaimConditionModel{
+				Claim:    types.StringValue(c.Claim),
+				Operator: types.StringValue(c.Operator),
+				Values:   values,
+			}
+		}
 		if p.EnvironmentFilter != nil {
 			state.Policies[i].EnvironmentFilter = types.StringValue(*p.EnvironmentFilter)
 		} else {
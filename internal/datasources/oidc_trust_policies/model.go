@@ -10,14 +10,23 @@ type OidcTrustPoliciesModel struct {
 }
 
 type OidcTrustPolicyItemModel struct {
-	ID                types.String `tfsdk:"id"`
-	Name              types.String `tfsdk:"name"`
-	Provider          types.String `tfsdk:"oidc_provider"`
-	Repository        types.String `tfsdk:"repository"`
-	RefFilter         types.String `tfsdk:"ref_filter"`
-	EnvironmentFilter types.String `tfsdk:"environment_filter"`
-	Enabled           types.Bool   `tfsdk:"enabled"`
-	CreatedBy         types.String `tfsdk:"created_by"`
-	CreatedAt         types.String `tfsdk:"created_at"`
-	UpdatedAt         types.String `tfsdk:"updated_at"`
+	ID                types.String              `tfsdk:"id"`
+	Name              types.String              `tfsdk:"name"`
+	Provider          types.String              `tfsdk:"oidc_provider"`
+	IssuerURL         types.String              `tfsdk:"issuer_url"`
+	Audience          types.String              `tfsdk:"audience"`
+	Repository        types.String              `tfsdk:"repository"`
+	RefFilter         types.String              `tfsdk:"ref_filter"`
+	EnvironmentFilter types.String              `tfsdk:"environment_filter"`
+	ClaimConditions   []OidcClaimConditionModel `tfsdk:"claim_conditions"`
+	Enabled           types.Bool                `tfsdk:"enabled"`
+	CreatedBy         types.String              `tfsdk:"created_by"`
+	CreatedAt         types.String              `tfsdk:"created_at"`
+	UpdatedAt         types.String              `tfsdk:"updated_at"`
+}
+
+type OidcClaimConditionModel struct {
+	Claim    types.String `tfsdk:"claim"`
+	Operator types.String `tfsdk:"operator"`
+	Values   types.List   `tfsdk:"values"`
 }
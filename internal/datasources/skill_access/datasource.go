@@ -0,0 +1,192 @@
+package skill_access
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &SkillAccessDataSource{}
+	_ datasource.DataSourceWithConfigure = &SkillAccessDataSource{}
+)
+
+type SkillAccessDataSource struct {
+	client *client.Client
+}
+
+func NewDataSource() datasource.DataSource {
+	return &SkillAccessDataSource{}
+}
+
+func (d *SkillAccessDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_skill_access"
+}
+
+func (d *SkillAccessDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Finds the OIDC trust policies that grant CI/CD pipelines access to a skill, across every team the authenticated user belongs to.",
+		Attributes: map[string]schema.Attribute{
+			"skill_id": schema.StringAttribute{
+				Description: "The ID of the skill to look up.",
+				Required:    true,
+			},
+			"include_disabled": schema.BoolAttribute{
+				Description: "If true, disabled policies that would grant the skill are included too. Defaults to false.",
+				Optional:    true,
+			},
+			"policies": schema.ListNestedAttribute{
+				Description: "The policies granting the skill, ordered by team and name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tenant_id": schema.StringAttribute{
+							Description: "The team (tenant) the policy belongs to.",
+							Computed:    true,
+						},
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the policy.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the policy.",
+							Computed:    true,
+						},
+						"oidc_provider": schema.StringAttribute{
+							Description: "The OIDC provider of the policy.",
+							Computed:    true,
+						},
+						"repository": schema.StringAttribute{
+							Description: "The repository the policy trusts.",
+							Computed:    true,
+						},
+						"ref_filter": schema.StringAttribute{
+							Description: "The Git ref glob pattern of the policy.",
+							Computed:    true,
+						},
+						"environment_filter": schema.StringAttribute{
+							Description: "The environment filter of the policy.",
+							Computed:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "Whether the policy is enabled.",
+							Computed:    true,
+						},
+						"all_skills": schema.BoolAttribute{
+							Description: "Whether the policy grants the skill by granting every skill of its team, rather than by listing it in skill_ids.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"skipped_tenant_ids": schema.ListAttribute{
+				Description: "Teams whose policies the authenticated user may not read. Policies of these teams are not included, so an empty policies list only proves the skill is unused when this is empty too.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *SkillAccessDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.client = c
+}
+
+func (d *SkillAccessDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SkillAccessModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	skillID := config.SkillID.ValueString()
+
+	// Policies without skill_ids grant every skill of their own team, so the
+	// team of the skill is needed to find them
+	var skillTenantID string
+	skill, err := d.client.GetSkill(ctx, skillID)
+	switch {
+	case err == nil:
+		skillTenantID = skill.TenantID
+	case client.IsNotFound(err):
+		tflog.Warn(ctx, "Skill not found, only policies listing it explicitly are reported", map[string]interface{}{"skill_id": skillID})
+	default:
+		resp.Diagnostics.AddError("Error reading skill", err.Error())
+		return
+	}
+
+	tenants, err := d.client.ListTenants(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading teams", err.Error())
+		return
+	}
+	tenantIDs := make([]string, len(tenants))
+	for i, t := range tenants {
+		tenantIDs[i] = t.ID
+	}
+	sort.Strings(tenantIDs)
+
+	config.Policies = []SkillAccessPolicyModel{}
+	skipped := []string{}
+	for _, tenantID := range tenantIDs {
+		policies, err := d.client.ListOIDCPolicies(ctx, tenantID)
+		if err != nil {
+			if client.IsUnauthorized(err) || client.IsNotFound(err) {
+				skipped = append(skipped, tenantID)
+				continue
+			}
+			resp.Diagnostics.AddError("Error reading OIDC trust policies", fmt.Sprintf("team %s: %s", tenantID, err))
+			return
+		}
+
+		sort.SliceStable(policies, func(i, j int) bool {
+			return policies[i].Name < policies[j].Name
+		})
+		for _, p := range policies {
+			if !p.Enabled && !config.IncludeDisabled.ValueBool() {
+				continue
+			}
+			allSkills := len(p.SkillIDs) == 0 && tenantID == skillTenantID
+			if !allSkills && !slices.Contains(p.SkillIDs, skillID) {
+				continue
+			}
+			config.Policies = append(config.Policies, SkillAccessPolicyModel{
+				TenantID:          types.StringValue(tenantID),
+				ID:                types.StringValue(p.ID),
+				Name:              types.StringValue(p.Name),
+				Provider:          types.StringValue(p.Provider),
+				Repository:        types.StringValue(p.Repository),
+				RefFilter:         types.StringValue(p.RefFilter),
+				EnvironmentFilter: types.StringPointerValue(p.EnvironmentFilter),
+				Enabled:           types.BoolValue(p.Enabled),
+				AllSkills:         types.BoolValue(allSkills),
+			})
+		}
+	}
+
+	skippedList, diags := types.ListValueFrom(ctx, types.StringType, skipped)
+	resp.Diagnostics.Append(diags...)
+	config.SkippedTenantIDs = skippedList
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
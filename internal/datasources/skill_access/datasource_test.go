@@ -0,0 +1,130 @@
+package skill_access_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/datasources/skill_access"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
+)
+
+func TestAccSkillAccessDataSource_basic(t *testing.T) {
+	name := testutils.RandomName("tf-test-ds-access")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkillAccessDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.localskills_skill_access.test", "policies.#"),
+					resource.TestCheckResourceAttrSet("data.localskills_skill_access.test", "skipped_tenant_ids.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSkillAccessDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "localskills_skill" "test" {
+  tenant_id  = "default"
+  name       = %q
+  type       = "skill"
+  visibility = "private"
+  content    = "# Test Skill"
+}
+
+data "localskills_skill_access" "test" {
+  skill_id = localskills_skill.test.id
+}
+`, name)
+}
+
+func TestSkillAccessDataSource_read(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/skills/sk-1", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, map[string]interface{}{"id": "sk-1", "tenantId": "tenant-1", "name": "eslint-rules"})
+	})
+	mux.HandleFunc("/api/tenants", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "tenant-3", "name": "Docs", "role": "member"},
+			{"id": "tenant-2", "name": "Web", "role": "admin"},
+			{"id": "tenant-1", "name": "Platform", "role": "admin"},
+		})
+	})
+	mux.HandleFunc("/api/tenants/tenant-1/oidc-policies", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "pol-release", "name": "release", "provider": "github", "repository": "org/app", "refFilter": "refs/tags/*", "skillIds": []string{"sk-1", "sk-2"}, "enabled": true},
+			{"id": "pol-other", "name": "other", "provider": "github", "repository": "org/app", "refFilter": "*", "skillIds": []string{"sk-2"}, "enabled": true},
+			{"id": "pol-all", "name": "all", "provider": "gitlab", "repository": "org/infra", "refFilter": "*", "enabled": true},
+			{"id": "pol-off", "name": "disabled", "provider": "github", "repository": "org/old", "refFilter": "*", "skillIds": []string{"sk-1"}, "enabled": false},
+		})
+	})
+	mux.HandleFunc("/api/tenants/tenant-2/oidc-policies", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "pol-web", "name": "web", "provider": "github", "repository": "web/site", "refFilter": "refs/heads/main", "skillIds": []string{"sk-1"}, "enabled": true},
+			{"id": "pol-web-all", "name": "web all", "provider": "github", "repository": "web/site", "refFilter": "*", "enabled": true},
+		})
+	})
+	mux.HandleFunc("/api/tenants/tenant-3/oidc-policies", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]interface{}{"success": false, "error": "Forbidden"})
+	})
+
+	state, diags := testutils.ReadDataSource(t, skill_access.NewDataSource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"skill_id": tftypes.NewValue(tftypes.String, "sk-1"),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+
+	ctx := context.Background()
+	var ids []string
+	var allSkills []bool
+	for i := 0; ; i++ {
+		var id *string
+		if state.GetAttribute(ctx, path.Root("policies").AtListIndex(i).AtName("id"), &id).HasError() || id == nil {
+			break
+		}
+		var all bool
+		state.GetAttribute(ctx, path.Root("policies").AtListIndex(i).AtName("all_skills"), &all)
+		ids = append(ids, *id)
+		allSkills = append(allSkills, all)
+	}
+	want := []string{"pol-all", "pol-release", "pol-web"}
+	if len(ids) != len(want) {
+		t.Fatalf("expected policies %v, got %v", want, ids)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("expected policies %v, got %v", want, ids)
+		}
+	}
+	if !allSkills[0] || allSkills[1] || allSkills[2] {
+		t.Errorf("expected only pol-all to grant every skill, got %v", allSkills)
+	}
+
+	var repository string
+	state.GetAttribute(ctx, path.Root("policies").AtListIndex(2).AtName("repository"), &repository)
+	if repository != "web/site" {
+		t.Errorf("expected repository web/site, got %q", repository)
+	}
+
+	var skipped []string
+	state.GetAttribute(ctx, path.Root("skipped_tenant_ids"), &skipped)
+	if len(skipped) != 1 || skipped[0] != "tenant-3" {
+		t.Errorf("expected tenant-3 to be skipped, got %v", skipped)
+	}
+}
@@ -0,0 +1,24 @@
+package skill_access
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SkillAccessModel struct {
+	SkillID          types.String             `tfsdk:"skill_id"`
+	IncludeDisabled  types.Bool               `tfsdk:"include_disabled"`
+	Policies         []SkillAccessPolicyModel `tfsdk:"policies"`
+	SkippedTenantIDs types.List               `tfsdk:"skipped_tenant_ids"`
+}
+
+type SkillAccessPolicyModel struct {
+	TenantID          types.String `tfsdk:"tenant_id"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Provider          types.String `tfsdk:"oidc_provider"`
+	Repository        types.String `tfsdk:"repository"`
+	RefFilter         types.String `tfsdk:"ref_filter"`
+	EnvironmentFilter types.String `tfsdk:"environment_filter"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	AllSkills         types.Bool   `tfsdk:"all_skills"`
+}
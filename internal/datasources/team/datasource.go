@@ -4,15 +4,19 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/teamsettings"
 )
 
 var (
-	_ datasource.DataSource              = &TeamDataSource{}
-	_ datasource.DataSourceWithConfigure = &TeamDataSource{}
+	_ datasource.DataSource                     = &TeamDataSource{}
+	_ datasource.DataSourceWithConfigure        = &TeamDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &TeamDataSource{}
 )
 
 type TeamDataSource struct {
@@ -31,18 +35,21 @@ func (d *TeamDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 	resp.Schema = schema.Schema{
 		Description: "Fetches a single team (tenant) by ID or slug.",
 		Attributes: map[string]schema.Attribute{
-			"team_id": schema.StringAttribute{
-				Description: "The ID of the team to look up. At least one of team_id or slug must be specified.",
+			"id": schema.StringAttribute{
+				Description: "The ID of the team to look up. Exactly one of id or slug must be specified.",
 				Optional:    true,
+				Computed:    true,
 			},
 			"slug": schema.StringAttribute{
-				Description: "The slug of the team to look up. At least one of team_id or slug must be specified.",
+				Description: "The slug of the team to look up. Exactly one of id or slug must be specified.",
 				Optional:    true,
 				Computed:    true,
 			},
-			"id": schema.StringAttribute{
-				Description: "The unique identifier of the team.",
-				Computed:    true,
+			"team_id": schema.StringAttribute{
+				Description:        "The ID of the team to look up. Deprecated alias of id.",
+				DeprecationMessage: "Use id instead. team_id will be removed in a future major version.",
+				Optional:           true,
+				Computed:           true,
 			},
 			"name": schema.StringAttribute{
 				Description: "The name of the team.",
@@ -56,10 +63,38 @@ func (d *TeamDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Description: "The authenticated user's role in this team.",
 				Computed:    true,
 			},
+			"default_visibility": schema.StringAttribute{
+				Description: "The visibility given to new skills in the team when none is specified.",
+				Computed:    true,
+			},
+			"allowed_visibilities": schema.SetAttribute{
+				Description: "The visibilities members may give skills in the team. Empty if unrestricted.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"allow_public_skills": schema.BoolAttribute{
+				Description: "Whether the team may own public skills.",
+				Computed:    true,
+			},
+			"allowed_email_domains": schema.SetAttribute{
+				Description: "The email domains that may be invited to the team. Empty if any domain is allowed.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 		},
 	}
 }
 
+func (d *TeamDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("slug"),
+			path.MatchRoot("team_id"),
+		),
+	}
+}
+
 func (d *TeamDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -84,13 +119,11 @@ func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	if config.TeamID.IsNull() && config.Slug.IsNull() {
-		resp.Diagnostics.AddError(
-			"Missing Required Attribute",
-			"At least one of team_id or slug must be specified.",
-		)
-		return
+	id := config.ID.ValueString()
+	if !config.TeamID.IsNull() {
+		id = config.TeamID.ValueString()
 	}
+	slug := config.Slug.ValueString()
 
 	tenants, err := d.client.ListTenants(ctx)
 	if err != nil {
@@ -98,28 +131,33 @@ func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	var found bool
-	for _, t := range tenants {
-		if (!config.TeamID.IsNull() && t.ID == config.TeamID.ValueString()) ||
-			(!config.Slug.IsNull() && t.Slug == config.Slug.ValueString()) {
-			config.ID = types.StringValue(t.ID)
-			config.TeamID = types.StringValue(t.ID)
-			config.Name = types.StringValue(t.Name)
-			config.Slug = types.StringValue(t.Slug)
-			config.Description = types.StringValue(t.Description)
-			config.Role = types.StringValue(t.Role)
-			found = true
+	var found *client.TenantWithRole
+	for i := range tenants {
+		if (id != "" && tenants[i].ID == id) || (id == "" && tenants[i].Slug == slug) {
+			found = &tenants[i]
 			break
 		}
 	}
 
-	if !found {
+	if found == nil {
+		lookup := fmt.Sprintf("slug %q", slug)
+		if id != "" {
+			lookup = fmt.Sprintf("ID %q", id)
+		}
 		resp.Diagnostics.AddError(
 			"Team Not Found",
-			"No team matching the specified criteria was found.",
+			fmt.Sprintf("No team with %s was found among the teams the authenticated user belongs to.", lookup),
 		)
 		return
 	}
 
+	config.ID = types.StringValue(found.ID)
+	config.TeamID = types.StringValue(found.ID)
+	config.Name = types.StringValue(found.Name)
+	config.Slug = types.StringValue(found.Slug)
+	config.Description = types.StringValue(found.Description)
+	config.Role = types.StringValue(found.Role)
+	config.Model = teamsettings.Map(ctx, &found.TenantSettings, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 }
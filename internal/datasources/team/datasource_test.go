@@ -1,9 +1,17 @@
 package team_test
 
 import (
+	"context"
+	"net/http"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/datasources/team"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
 )
 
@@ -21,6 +29,7 @@ func TestAccTeamDataSource_byID(t *testing.T) {
 					resource.TestCheckResourceAttr("data.localskills_team.test", "name", teamName),
 					resource.TestCheckResourceAttrSet("data.localskills_team.test", "slug"),
 					resource.TestCheckResourceAttrSet("data.localskills_team.test", "role"),
+					resource.TestCheckResourceAttr("data.localskills_team.test", "default_visibility", "private"),
 				),
 			},
 		},
@@ -46,14 +55,123 @@ func TestAccTeamDataSource_bySlug(t *testing.T) {
 	})
 }
 
+func TestAccTeamDataSource_byTeamID(t *testing.T) {
+	testutils.TestAccPreCheck(t)
+	teamName := testutils.RandomName("tf-test-team")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamDataSourceByTeamIDConfig(teamName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.localskills_team.test", "id", "localskills_team.test", "id"),
+					resource.TestCheckResourceAttrPair("data.localskills_team.test", "team_id", "localskills_team.test", "id"),
+					resource.TestCheckResourceAttr("data.localskills_team.test", "name", teamName),
+				),
+			},
+		},
+	})
+}
+
+func TestTeamDataSource_read(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/tenants", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "tenant-1", "name": "Team One", "slug": "team-one", "role": "owner", "defaultVisibility": "private", "allowedEmailDomains": []string{"example.com"}},
+			{"id": "tenant-2", "name": "Team Two", "slug": "team-two", "role": "member"},
+		})
+	})
+
+	cases := map[string]struct {
+		attrs  map[string]string
+		wantID string
+	}{
+		"id":           {attrs: map[string]string{"id": "tenant-1"}, wantID: "tenant-1"},
+		"team_id":      {attrs: map[string]string{"team_id": "tenant-2"}, wantID: "tenant-2"},
+		"slug":         {attrs: map[string]string{"slug": "team-one"}, wantID: "tenant-1"},
+		"unknown id":   {attrs: map[string]string{"team_id": "tenant-9"}},
+		"unknown slug": {attrs: map[string]string{"slug": "team-nine"}},
+	}
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			attrs := map[string]tftypes.Value{}
+			for attrName, v := range tc.attrs {
+				attrs[attrName] = tftypes.NewValue(tftypes.String, v)
+			}
+			state, diags := testutils.ReadDataSource(t, team.NewDataSource(), c, attrs)
+			if tc.wantID == "" {
+				if !diags.HasError() {
+					t.Fatal("expected an error for a team that does not exist")
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %s", diags)
+			}
+			testutils.CheckStringAttribute(t, state.GetAttribute, "id", tc.wantID)
+			testutils.CheckStringAttribute(t, state.GetAttribute, "team_id", tc.wantID)
+		})
+	}
+}
+
+func TestTeamDataSource_configValidators(t *testing.T) {
+	ctx := context.Background()
+	d := team.NewDataSource()
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	cases := map[string]struct {
+		attrs   map[string]string
+		wantErr bool
+	}{
+		"id":           {attrs: map[string]string{"id": "tenant-1"}},
+		"slug":         {attrs: map[string]string{"slug": "team-one"}},
+		"team_id":      {attrs: map[string]string{"team_id": "tenant-1"}},
+		"neither":      {attrs: map[string]string{}, wantErr: true},
+		"id and slug":  {attrs: map[string]string{"id": "tenant-1", "slug": "team-one"}, wantErr: true},
+		"id and alias": {attrs: map[string]string{"id": "tenant-1", "team_id": "tenant-1"}, wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			values := map[string]tftypes.Value{}
+			for attrName, typ := range objectType.AttributeTypes {
+				values[attrName] = tftypes.NewValue(typ, nil)
+			}
+			for attrName, v := range tc.attrs {
+				values[attrName] = tftypes.NewValue(tftypes.String, v)
+			}
+			config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+
+			var diags diag.Diagnostics
+			for _, v := range d.(datasource.DataSourceWithConfigValidators).ConfigValidators(ctx) {
+				resp := &datasource.ValidateConfigResponse{}
+				v.ValidateDataSource(ctx, datasource.ValidateConfigRequest{Config: config}, resp)
+				diags.Append(resp.Diagnostics...)
+			}
+			if diags.HasError() != tc.wantErr {
+				t.Errorf("expected error %v, got %s", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
 func testAccTeamDataSourceByIDConfig(name string) string {
 	return `
 resource "localskills_team" "test" {
-  name = "` + name + `"
+  name               = "` + name + `"
+  default_visibility = "private"
 }
 
 data "localskills_team" "test" {
-  team_id = localskills_team.test.id
+  id = localskills_team.test.id
 }
 `
 }
@@ -69,3 +187,15 @@ data "localskills_team" "test" {
 }
 `
 }
+
+func testAccTeamDataSourceByTeamIDConfig(name string) string {
+	return `
+resource "localskills_team" "test" {
+  name = "` + name + `"
+}
+
+data "localskills_team" "test" {
+  team_id = localskills_team.test.id
+}
+`
+}
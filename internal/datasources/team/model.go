@@ -2,6 +2,7 @@ package team
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/teamsettings"
 )
 
 type TeamDataSourceModel struct {
@@ -11,4 +12,6 @@ type TeamDataSourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 	Role        types.String `tfsdk:"role"`
+
+	teamsettings.Model
 }
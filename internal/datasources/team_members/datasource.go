@@ -0,0 +1,120 @@
+package team_members
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &TeamMembersDataSource{}
+	_ datasource.DataSourceWithConfigure = &TeamMembersDataSource{}
+)
+
+type TeamMembersDataSource struct {
+	client *client.Client
+}
+
+func NewDataSource() datasource.DataSource {
+	return &TeamMembersDataSource{}
+}
+
+func (d *TeamMembersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_members"
+}
+
+func (d *TeamMembersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches all members of a team.",
+		Attributes: map[string]schema.Attribute{
+			"tenant_id": schema.StringAttribute{
+				Description: "The ID of the team (tenant) to list members for.",
+				Required:    true,
+			},
+			"members": schema.ListNestedAttribute{
+				Description: "List of members.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Description: "The ID of the member.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "The email address of the member.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The display name of the member.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "The role of the member in the team.",
+							Computed:    true,
+						},
+						"joined_at": schema.StringAttribute{
+							Description: "The timestamp when the member joined the team.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TeamMembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *TeamMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config TeamMembersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := d.client.ListMembers(ctx, config.TenantID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing team members", err.Error())
+		return
+	}
+
+	var state TeamMembersDataSourceModel
+	state.TenantID = config.TenantID
+	state.Members = []MemberModel{}
+
+	for _, m := range members {
+		model := MemberModel{
+			UserID:   types.StringValue(m.UserID),
+			Email:    types.StringValue(m.Email),
+			Role:     types.StringValue(m.Role),
+			JoinedAt: types.StringValue(m.JoinedAt),
+		}
+		if m.Name != nil {
+			model.Name = types.StringValue(*m.Name)
+		} else {
+			model.Name = types.StringNull()
+		}
+		state.Members = append(state.Members, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
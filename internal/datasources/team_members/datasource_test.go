@@ -0,0 +1,39 @@
+package team_members_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
+)
+
+func TestAccTeamMembersDataSource_basic(t *testing.T) {
+	testutils.TestAccPreCheck(t)
+	teamName := testutils.RandomName("tf-test-team")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamMembersDataSourceConfig(teamName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.localskills_team_members.test", "tenant_id"),
+					resource.TestCheckResourceAttr("data.localskills_team_members.test", "members.#", "1"),
+					resource.TestCheckResourceAttr("data.localskills_team_members.test", "members.0.role", "owner"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamMembersDataSourceConfig(teamName string) string {
+	return `
+resource "localskills_team" "test" {
+  name = "` + teamName + `"
+}
+
+data "localskills_team_members" "test" {
+  tenant_id = localskills_team.test.id
+}
+`
+}
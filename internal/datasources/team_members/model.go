@@ -0,0 +1,18 @@
+package team_members
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TeamMembersDataSourceModel struct {
+	TenantID types.String  `tfsdk:"tenant_id"`
+	Members  []MemberModel `tfsdk:"members"`
+}
+
+type MemberModel struct {
+	UserID   types.String `tfsdk:"user_id"`
+	Email    types.String `tfsdk:"email"`
+	Name     types.String `tfsdk:"name"`
+	Role     types.String `tfsdk:"role"`
+	JoinedAt types.String `tfsdk:"joined_at"`
+}
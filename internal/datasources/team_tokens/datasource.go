@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/tokenscope"
 )
 
 var _ datasource.DataSource = &teamTokensDataSource{}
@@ -65,6 +66,16 @@ func (d *teamTokensDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 							Description: "Email of the user who created the token.",
 							Computed:    true,
 						},
+						"scopes": schema.ListAttribute{
+							Description: "The scopes the token is limited to. Empty if the token has every permission of its owner.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"skill_ids": schema.ListAttribute{
+							Description: "The skills the token's skills:* scopes are restricted to. Empty if they apply to every skill.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -125,6 +136,9 @@ func (d *teamTokensDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		} else {
 			state.Tokens[i].CreatedByName = types.StringNull()
 		}
+
+		state.Tokens[i].Scopes = tokenscope.List(t.Scopes)
+		state.Tokens[i].SkillIDs = tokenscope.List(t.SkillIDs)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
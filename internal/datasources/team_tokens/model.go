@@ -17,4 +17,6 @@ type TeamTokenItemModel struct {
 	CreatedAt      types.String `tfsdk:"created_at"`
 	CreatedByName  types.String `tfsdk:"created_by_name"`
 	CreatedByEmail types.String `tfsdk:"created_by_email"`
+	Scopes         types.List   `tfsdk:"scopes"`
+	SkillIDs       types.List   `tfsdk:"skill_ids"`
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/tokenscope"
 )
 
 var _ datasource.DataSource = &userTokensDataSource{}
@@ -53,6 +54,16 @@ func (d *userTokensDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 							Description: "When the token was created.",
 							Computed:    true,
 						},
+						"scopes": schema.ListAttribute{
+							Description: "The scopes the token is limited to. Empty if the token has every permission of its owner.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"skill_ids": schema.ListAttribute{
+							Description: "The skills the token's skills:* scopes are restricted to. Empty if they apply to every skill.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -100,6 +111,9 @@ func (d *userTokensDataSource) Read(ctx context.Context, _ datasource.ReadReques
 		} else {
 			state.Tokens[i].ExpiresAt = types.StringNull()
 		}
+
+		state.Tokens[i].Scopes = tokenscope.List(t.Scopes)
+		state.Tokens[i].SkillIDs = tokenscope.List(t.SkillIDs)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
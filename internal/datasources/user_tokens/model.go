@@ -14,4 +14,6 @@ type UserTokenItemModel struct {
 	LastUsedAt types.String `tfsdk:"last_used_at"`
 	ExpiresAt  types.String `tfsdk:"expires_at"`
 	CreatedAt  types.String `tfsdk:"created_at"`
+	Scopes     types.List   `tfsdk:"scopes"`
+	SkillIDs   types.List   `tfsdk:"skill_ids"`
 }
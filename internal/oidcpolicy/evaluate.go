@@ -0,0 +1,122 @@
+package oidcpolicy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+)
+
+// Claims are the claims of an OIDC token, keyed by claim name. Whatever the
+// CI provider calls them, the repository, Git ref and deployment environment
+// are expected under "repository", "ref" and "environment". The issuer and
+// audience are the standard "iss" and "aud" claims.
+type Claims map[string]string
+
+// Evaluate returns every reason policy does not match claims, or nil when it
+// does. A claim condition on a claim that is missing from claims never holds,
+// and neither does an issuer_url or audience when "iss" or "aud" is missing.
+func Evaluate(policy client.OidcTrustPolicy, claims Claims) []string {
+	var reasons []string
+
+	if !policy.Enabled {
+		reasons = append(reasons, "the policy is disabled")
+	}
+
+	if repository, ok := claims["repository"]; !ok {
+		reasons = append(reasons, "the repository claim is missing")
+	} else if repository != policy.Repository {
+		reasons = append(reasons, fmt.Sprintf("repository %q does not equal %q", repository, policy.Repository))
+	}
+
+	refFilter := policy.RefFilter
+	if refFilter == "" {
+		refFilter = "*"
+	}
+	if ref, ok := claims["ref"]; !ok {
+		if refFilter != "*" {
+			reasons = append(reasons, fmt.Sprintf("the ref claim is missing but ref_filter is %q", refFilter))
+		}
+	} else if !MatchGlob(refFilter, ref) {
+		reasons = append(reasons, fmt.Sprintf("ref %q does not match ref_filter %q", ref, refFilter))
+	}
+
+	if policy.EnvironmentFilter != nil && *policy.EnvironmentFilter != "" {
+		if environment, ok := claims["environment"]; !ok {
+			reasons = append(reasons, fmt.Sprintf("the environment claim is missing but environment_filter is %q", *policy.EnvironmentFilter))
+		} else if !MatchGlob(*policy.EnvironmentFilter, environment) {
+			reasons = append(reasons, fmt.Sprintf("environment %q does not match environment_filter %q", environment, *policy.EnvironmentFilter))
+		}
+	}
+
+	if policy.IssuerURL != nil && *policy.IssuerURL != "" {
+		if issuer, ok := claims["iss"]; !ok {
+			reasons = append(reasons, fmt.Sprintf("the iss claim is missing but issuer_url is %q", *policy.IssuerURL))
+		} else if issuer != *policy.IssuerURL {
+			reasons = append(reasons, fmt.Sprintf("iss %q does not equal issuer_url %q", issuer, *policy.IssuerURL))
+		}
+	}
+
+	if policy.Audience != nil && *policy.Audience != "" {
+		if audience, ok := claims["aud"]; !ok {
+			reasons = append(reasons, fmt.Sprintf("the aud claim is missing but audience is %q", *policy.Audience))
+		} else if audience != *policy.Audience {
+			reasons = append(reasons, fmt.Sprintf("aud %q does not equal audience %q", audience, *policy.Audience))
+		}
+	}
+
+	for _, condition := range policy.ClaimConditions {
+		if reason := evaluateCondition(condition, claims); reason != "" {
+			reasons = append(reasons, reason)
+		}
+	}
+	return reasons
+}
+
+func evaluateCondition(condition client.OidcClaimCondition, claims Claims) string {
+	value, ok := claims[condition.Claim]
+	if !ok {
+		return fmt.Sprintf("the %s claim is missing but a claim condition requires it", condition.Claim)
+	}
+
+	values := strings.Join(quoteAll(condition.Values), ", ")
+	switch condition.Operator {
+	case "in":
+		if !slices.Contains(condition.Values, value) {
+			return fmt.Sprintf("%s %q is not one of %s", condition.Claim, value, values)
+		}
+	case "not_in":
+		if slices.Contains(condition.Values, value) {
+			return fmt.Sprintf("%s %q is one of %s", condition.Claim, value, values)
+		}
+	case "glob":
+		if !matchesAny(condition.Values, value) {
+			return fmt.Sprintf("%s %q does not match any of %s", condition.Claim, value, values)
+		}
+	case "not_glob":
+		if matchesAny(condition.Values, value) {
+			return fmt.Sprintf("%s %q matches one of %s", condition.Claim, value, values)
+		}
+	default:
+		return fmt.Sprintf("the claim condition on %s has the unknown operator %q", condition.Claim, condition.Operator)
+	}
+	return ""
+}
+
+func matchesAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if MatchGlob(pattern, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func quoteAll(values []string) []string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return quoted
+}
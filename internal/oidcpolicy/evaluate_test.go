@@ -0,0 +1,131 @@
+package oidcpolicy_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/oidcpolicy"
+)
+
+func TestMatchGlob(t *testing.T) {
+	cases := []struct {
+		pattern string
+		value   string
+		want    bool
+	}{
+		{"*", "refs/heads/main", true},
+		{"refs/heads/main", "refs/heads/main", true},
+		{"refs/heads/main", "refs/heads/main-2", false},
+		{"refs/tags/*", "refs/tags/v1.2.0", true},
+		{"refs/tags/*", "refs/tags/release/v1", true},
+		{"refs/heads/release-?", "refs/heads/release-1", true},
+		{"refs/heads/release-?", "refs/heads/release-10", false},
+		{"refs/tags/v[0-9]*", "refs/tags/v2", true},
+		{"refs/tags/v[!0-9]*", "refs/tags/v2", false},
+		{`refs/heads/\*`, "refs/heads/*", true},
+		{`refs/heads/\*`, "refs/heads/main", false},
+		{"refs/heads/[main", "refs/heads/[main", false},
+		{`*\[bot]`, "dependabot[bot]", true},
+		{"*[bot]", "dependabot[bot]", false},
+	}
+
+	for _, tc := range cases {
+		if got := oidcpolicy.MatchGlob(tc.pattern, tc.value); got != tc.want {
+			t.Errorf("MatchGlob(%q, %q) = %t, want %t", tc.pattern, tc.value, got, tc.want)
+		}
+	}
+}
+
+func TestCompileGlob_invalid(t *testing.T) {
+	for _, pattern := range []string{"refs/heads/[main", `refs/heads/\`, "refs/[]", "refs/[z-a]"} {
+		if _, err := oidcpolicy.CompileGlob(pattern); err == nil {
+			t.Errorf("expected an error for %q", pattern)
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	production := "production"
+	policy := client.OidcTrustPolicy{
+		Repository:        "org/repo",
+		RefFilter:         "refs/heads/main",
+		EnvironmentFilter: &production,
+		Enabled:           true,
+		ClaimConditions: []client.OidcClaimCondition{
+			{Claim: "actor", Operator: "not_in", Values: []string{"dependabot[bot]", "renovate[bot]"}},
+		},
+	}
+	claims := oidcpolicy.Claims{"repository": "org/repo", "ref": "refs/heads/main", "environment": "production", "actor": "octocat"}
+
+	cases := map[string]struct {
+		policy  func(p client.OidcTrustPolicy) client.OidcTrustPolicy
+		claims  map[string]string
+		reasons []string
+	}{
+		"match": {},
+		"disabled": {
+			policy:  func(p client.OidcTrustPolicy) client.OidcTrustPolicy { p.Enabled = false; return p },
+			reasons: []string{"the policy is disabled"},
+		},
+		"other repository and ref": {
+			claims:  map[string]string{"repository": "org/other", "ref": "refs/heads/dev"},
+			reasons: []string{`repository "org/other" does not equal "org/repo"`, `ref "refs/heads/dev" does not match ref_filter "refs/heads/main"`},
+		},
+		"missing environment": {
+			claims:  map[string]string{"environment": ""},
+			reasons: []string{`environment "" does not match environment_filter "production"`},
+		},
+		"failed claim condition": {
+			claims:  map[string]string{"actor": "dependabot[bot]"},
+			reasons: []string{`actor "dependabot[bot]" is one of "dependabot[bot]", "renovate[bot]"`},
+		},
+		"any ref with the default ref_filter": {
+			policy: func(p client.OidcTrustPolicy) client.OidcTrustPolicy { p.RefFilter = ""; return p },
+			claims: map[string]string{"ref": "refs/pull/1/merge"},
+		},
+		"custom issuer and audience": {
+			policy: customProvider,
+			claims: map[string]string{"iss": "https://ci.example.com", "aud": "localskills"},
+		},
+		"other issuer and audience": {
+			policy:  customProvider,
+			claims:  map[string]string{"iss": "https://ci.example.org", "aud": "other"},
+			reasons: []string{`iss "https://ci.example.org" does not equal issuer_url "https://ci.example.com"`, `aud "other" does not equal audience "localskills"`},
+		},
+		"missing issuer and audience": {
+			policy:  customProvider,
+			reasons: []string{`the iss claim is missing but issuer_url is "https://ci.example.com"`, `the aud claim is missing but audience is "localskills"`},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := policy
+			if tc.policy != nil {
+				p = tc.policy(p)
+			}
+			c := oidcpolicy.Claims{}
+			for k, v := range claims {
+				c[k] = v
+			}
+			for k, v := range tc.claims {
+				c[k] = v
+			}
+
+			got := strings.Join(oidcpolicy.Evaluate(p, c), "; ")
+			if want := strings.Join(tc.reasons, "; "); got != want {
+				t.Errorf("expected reasons %q, got %q", want, got)
+			}
+		})
+	}
+}
+
+// customProvider sets p up as a policy for a custom OIDC provider.
+func customProvider(p client.OidcTrustPolicy) client.OidcTrustPolicy {
+	issuer, audience := "https://ci.example.com", "localskills"
+	p.Provider = "custom"
+	p.IssuerURL = &issuer
+	p.Audience = &audience
+	return p
+}
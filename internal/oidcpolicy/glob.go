@@ -0,0 +1,68 @@
+// Package oidcpolicy evaluates and validates OIDC trust policies. Evaluation
+// checks the claims of an OIDC token against each condition a policy sets,
+// and does not verify the token itself.
+package oidcpolicy
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// CompileGlob compiles a ref or claim glob. "*" matches any run of
+// characters, including "/", "?" matches a single character and "[...]"
+// matches one character of a set or range, negated with a leading "!".
+// Anything else, including a backslash-escaped metacharacter, matches
+// itself. The whole value has to match.
+func CompileGlob(pattern string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		case '\\':
+			if i+1 == len(pattern) {
+				return nil, fmt.Errorf("trailing backslash at position %d", i+1)
+			}
+			i++
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated character class at position %d", i+1)
+			}
+			class := pattern[i+1 : i+1+end]
+			negate := strings.HasPrefix(class, "!")
+			class = strings.TrimPrefix(class, "!")
+			if class == "" {
+				return nil, fmt.Errorf("empty character class at position %d", i+1)
+			}
+			b.WriteString("[")
+			if negate {
+				b.WriteString("^")
+			}
+			b.WriteString(strings.NewReplacer(`\`, `\\`, `^`, `\^`, `[`, `\[`).Replace(class))
+			b.WriteString("]")
+			i += end + 1
+		default:
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	b.WriteString("$")
+
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid character class: %w", err)
+	}
+	return re, nil
+}
+
+// MatchGlob reports whether value matches pattern. An invalid pattern
+// matches nothing.
+func MatchGlob(pattern, value string) bool {
+	re, err := CompileGlob(pattern)
+	return err == nil && re.MatchString(value)
+}
@@ -0,0 +1,46 @@
+package oidcpolicy
+
+import (
+	"fmt"
+	"regexp"
+)
+
+type repositoryFormat struct {
+	pattern *regexp.Regexp
+	example string
+}
+
+// repositoryFormats are the repository identifiers each provider puts in its
+// tokens. Custom issuers are not checked.
+var repositoryFormats = map[string]repositoryFormat{
+	"github": {
+		pattern: regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-]{0,38}/[A-Za-z0-9._-]+$`),
+		example: "owner/repo",
+	},
+	"gitlab": {
+		pattern: regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]*(/[A-Za-z0-9_][A-Za-z0-9_.-]*)+$`),
+		example: "group/project or group/subgroup/project",
+	},
+	"buildkite": {
+		pattern: regexp.MustCompile(`^[a-z0-9][a-z0-9-]*/[a-z0-9][a-z0-9-]*$`),
+		example: "organization-slug/pipeline-slug",
+	},
+	"circleci": {
+		pattern: regexp.MustCompile(`^([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}|[A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+)$`),
+		example: "a project ID such as 9d2c1a3e-5b6f-4c7d-8e9f-0a1b2c3d4e5f, or org/repo",
+	},
+	"bitbucket": {
+		pattern: regexp.MustCompile(`^[a-z0-9_.-]+/[a-z0-9_.-]+$`),
+		example: "workspace/repo-slug",
+	},
+}
+
+// ValidateRepository returns an error describing the expected format when
+// repository is not a valid identifier for provider.
+func ValidateRepository(provider, repository string) error {
+	format, ok := repositoryFormats[provider]
+	if !ok || format.pattern.MatchString(repository) {
+		return nil
+	}
+	return fmt.Errorf("%s repositories are written as %s, got %q", provider, format.example, repository)
+}
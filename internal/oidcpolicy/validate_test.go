@@ -0,0 +1,35 @@
+package oidcpolicy_test
+
+import (
+	"testing"
+
+	"github.com/localskills-sh/terraform-provider-localskills/internal/oidcpolicy"
+)
+
+func TestValidateRepository(t *testing.T) {
+	cases := []struct {
+		provider   string
+		repository string
+		valid      bool
+	}{
+		{"github", "octo-org/octo.repo", true},
+		{"github", "octo-org", false},
+		{"github", "-octo/repo", false},
+		{"gitlab", "group/subgroup/project", true},
+		{"gitlab", "project", false},
+		{"buildkite", "acme/deploy-skills", true},
+		{"buildkite", "Acme/Deploy", false},
+		{"circleci", "9d2c1a3e-5b6f-4c7d-8e9f-0a1b2c3d4e5f", true},
+		{"circleci", "acme/skills", true},
+		{"bitbucket", "acme/skills_repo", true},
+		{"bitbucket", "acme/skills/extra", false},
+		{"custom", "anything at all", true},
+	}
+
+	for _, tc := range cases {
+		err := oidcpolicy.ValidateRepository(tc.provider, tc.repository)
+		if (err == nil) != tc.valid {
+			t.Errorf("ValidateRepository(%q, %q) = %v, want valid %t", tc.provider, tc.repository, err, tc.valid)
+		}
+	}
+}
@@ -5,13 +5,18 @@ import (
 	"os"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/providerdata"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/tokenlifecycle"
 
 	// Resources
 	oidctrustpolicyresource "github.com/localskills-sh/terraform-provider-localskills/internal/resources/oidc_trust_policy"
@@ -21,14 +26,20 @@ import (
 	ssoconnectionresource "github.com/localskills-sh/terraform-provider-localskills/internal/resources/sso_connection"
 	teamresource "github.com/localskills-sh/terraform-provider-localskills/internal/resources/team"
 	teaminvitationresource "github.com/localskills-sh/terraform-provider-localskills/internal/resources/team_invitation"
+	teaminvitationsresource "github.com/localskills-sh/terraform-provider-localskills/internal/resources/team_invitations"
+	teammemberresource "github.com/localskills-sh/terraform-provider-localskills/internal/resources/team_member"
+	teammembershipresource "github.com/localskills-sh/terraform-provider-localskills/internal/resources/team_membership"
 	teamtokenresource "github.com/localskills-sh/terraform-provider-localskills/internal/resources/team_token"
 	usertokenresource "github.com/localskills-sh/terraform-provider-localskills/internal/resources/user_token"
 
 	// Data Sources
+	credentialreportds "github.com/localskills-sh/terraform-provider-localskills/internal/datasources/credential_report"
 	exploreds "github.com/localskills-sh/terraform-provider-localskills/internal/datasources/explore"
+	oidcpolicyevaluationds "github.com/localskills-sh/terraform-provider-localskills/internal/datasources/oidc_policy_evaluation"
 	oidctrustpoliciesds "github.com/localskills-sh/terraform-provider-localskills/internal/datasources/oidc_trust_policies"
 	scimtokensds "github.com/localskills-sh/terraform-provider-localskills/internal/datasources/scim_tokens"
 	skillds "github.com/localskills-sh/terraform-provider-localskills/internal/datasources/skill"
+	skillaccessds "github.com/localskills-sh/terraform-provider-localskills/internal/datasources/skill_access"
 	skillanalyticsds "github.com/localskills-sh/terraform-provider-localskills/internal/datasources/skill_analytics"
 	skillcontentds "github.com/localskills-sh/terraform-provider-localskills/internal/datasources/skill_content"
 	skillmanifestds "github.com/localskills-sh/terraform-provider-localskills/internal/datasources/skill_manifest"
@@ -38,6 +49,7 @@ import (
 	teamds "github.com/localskills-sh/terraform-provider-localskills/internal/datasources/team"
 	teamauditlogds "github.com/localskills-sh/terraform-provider-localskills/internal/datasources/team_audit_log"
 	teaminvitationsds "github.com/localskills-sh/terraform-provider-localskills/internal/datasources/team_invitations"
+	teammembersds "github.com/localskills-sh/terraform-provider-localskills/internal/datasources/team_members"
 	teamtokensds "github.com/localskills-sh/terraform-provider-localskills/internal/datasources/team_tokens"
 	teamsds "github.com/localskills-sh/terraform-provider-localskills/internal/datasources/teams"
 	userauditlogds "github.com/localskills-sh/terraform-provider-localskills/internal/datasources/user_audit_log"
@@ -45,15 +57,21 @@ import (
 	usertokensds "github.com/localskills-sh/terraform-provider-localskills/internal/datasources/user_tokens"
 )
 
-var _ provider.Provider = &LocalskillsProvider{}
+var (
+	_ provider.Provider                  = &LocalskillsProvider{}
+	_ provider.ProviderWithListResources = &LocalskillsProvider{}
+)
 
 type LocalskillsProvider struct {
 	version string
 }
 
 type LocalskillsProviderModel struct {
-	BaseURL  types.String `tfsdk:"base_url"`
-	ApiToken types.String `tfsdk:"api_token"`
+	BaseURL                types.String `tfsdk:"base_url"`
+	ApiToken               types.String `tfsdk:"api_token"`
+	MaxTokenLifetimeDays   types.Int64  `tfsdk:"max_token_lifetime_days"`
+	TokenExpiryWarningDays types.Int64  `tfsdk:"token_expiry_warning_days"`
+	ErrorOnExpiredTokens   types.Bool   `tfsdk:"error_on_expired_tokens"`
 }
 
 func (p *LocalskillsProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -74,6 +92,24 @@ func (p *LocalskillsProvider) Schema(_ context.Context, _ provider.SchemaRequest
 				Required:    true,
 				Sensitive:   true,
 			},
+			"max_token_lifetime_days": schema.Int64Attribute{
+				Description: "The longest lifetime, in days, that token resources may request. Configurations asking for a longer lifetime, or for a token that never expires, are rejected before anything is created.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"token_expiry_warning_days": schema.Int64Attribute{
+				Description: "Plans warn about token resources that expire within this many days. Defaults to 14. Set to 0 to turn the warning off.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"error_on_expired_tokens": schema.BoolAttribute{
+				Description: "If true, plans fail when a token resource in state has already expired and the plan would keep it, instead of only warning. Defaults to false.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -138,7 +174,18 @@ func (p *LocalskillsProvider) Configure(ctx context.Context, req provider.Config
 	})
 
 	c := client.NewClient(baseURL, apiToken)
-	resp.ResourceData = c
+	policy := tokenlifecycle.Policy{
+		ExpiryWarningDays: tokenlifecycle.DefaultExpiryWarningDays,
+		ErrorOnExpired:    config.ErrorOnExpiredTokens.ValueBool(),
+	}
+	if !config.MaxTokenLifetimeDays.IsNull() && !config.MaxTokenLifetimeDays.IsUnknown() {
+		policy.MaxLifetimeDays = int(config.MaxTokenLifetimeDays.ValueInt64())
+	}
+	if !config.TokenExpiryWarningDays.IsNull() && !config.TokenExpiryWarningDays.IsUnknown() {
+		policy.ExpiryWarningDays = int(config.TokenExpiryWarningDays.ValueInt64())
+	}
+	resp.ResourceData = &providerdata.ResourceData{Client: c, TokenPolicy: policy}
+	resp.ListResourceData = c
 	resp.DataSourceData = c
 }
 
@@ -148,6 +195,9 @@ func (p *LocalskillsProvider) Resources(_ context.Context) []func() resource.Res
 		skillversionresource.NewResource,
 		teamresource.NewResource,
 		teaminvitationresource.NewResource,
+		teaminvitationsresource.NewResource,
+		teammemberresource.NewResource,
+		teammembershipresource.NewResource,
 		teamtokenresource.NewResource,
 		usertokenresource.NewResource,
 		oidctrustpolicyresource.NewResource,
@@ -156,6 +206,15 @@ func (p *LocalskillsProvider) Resources(_ context.Context) []func() resource.Res
 	}
 }
 
+func (p *LocalskillsProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		skillresource.NewListResource,
+		teamtokenresource.NewListResource,
+		oidctrustpolicyresource.NewListResource,
+		teaminvitationresource.NewListResource,
+	}
+}
+
 func (p *LocalskillsProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		skillds.NewDataSource,
@@ -168,14 +227,18 @@ func (p *LocalskillsProvider) DataSources(_ context.Context) []func() datasource
 		teamsds.NewDataSource,
 		teamds.NewDataSource,
 		teaminvitationsds.NewDataSource,
+		teammembersds.NewDataSource,
 		usertokensds.NewDataSource,
 		teamtokensds.NewDataSource,
 		oidctrustpoliciesds.NewDataSource,
+		oidcpolicyevaluationds.NewDataSource,
+		skillaccessds.NewDataSource,
 		ssoconnectionds.NewDataSource,
 		scimtokensds.NewDataSource,
 		userprofileds.NewDataSource,
 		userauditlogds.NewDataSource,
 		teamauditlogds.NewDataSource,
+		credentialreportds.NewDataSource,
 	}
 }
 
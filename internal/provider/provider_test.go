@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/providerdata"
 )
 
 func TestProvider_Metadata(t *testing.T) {
@@ -42,6 +43,13 @@ func TestProvider_Schema(t *testing.T) {
 
 func configureProvider(t *testing.T, baseURL, apiToken string, baseURLNull, apiTokenNull bool) provider.ConfigureResponse {
 	t.Helper()
+	return configureProviderWithSettings(t, baseURL, apiToken, baseURLNull, apiTokenNull, nil)
+}
+
+// configureProviderWithSettings is configureProvider with the optional token
+// settings in settings set and the rest left null.
+func configureProviderWithSettings(t *testing.T, baseURL, apiToken string, baseURLNull, apiTokenNull bool, settings map[string]tftypes.Value) provider.ConfigureResponse {
+	t.Helper()
 
 	p := New("test")()
 
@@ -49,8 +57,11 @@ func configureProvider(t *testing.T, baseURL, apiToken string, baseURLNull, apiT
 	p.Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
 
 	attrTypes := map[string]tftypes.Type{
-		"base_url":  tftypes.String,
-		"api_token": tftypes.String,
+		"base_url":                  tftypes.String,
+		"api_token":                 tftypes.String,
+		"max_token_lifetime_days":   tftypes.Number,
+		"token_expiry_warning_days": tftypes.Number,
+		"error_on_expired_tokens":   tftypes.Bool,
 	}
 
 	var baseURLVal, apiTokenVal tftypes.Value
@@ -65,10 +76,21 @@ func configureProvider(t *testing.T, baseURL, apiToken string, baseURLNull, apiT
 		apiTokenVal = tftypes.NewValue(tftypes.String, apiToken)
 	}
 
-	rawConfig := tftypes.NewValue(tftypes.Object{AttributeTypes: attrTypes}, map[string]tftypes.Value{
+	values := map[string]tftypes.Value{
 		"base_url":  baseURLVal,
 		"api_token": apiTokenVal,
-	})
+	}
+	for name, typ := range attrTypes {
+		if _, ok := values[name]; ok {
+			continue
+		}
+		if v, ok := settings[name]; ok {
+			values[name] = v
+		} else {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+	}
+	rawConfig := tftypes.NewValue(tftypes.Object{AttributeTypes: attrTypes}, values)
 
 	config, err := configToState(schemaResp.Schema, rawConfig)
 	if err != nil {
@@ -173,3 +195,67 @@ func TestProvider_EnvVarBaseURL(t *testing.T) {
 		t.Error("expected ResourceData to be set")
 	}
 }
+
+func TestProvider_MaxTokenLifetimeDays(t *testing.T) {
+	t.Setenv("LOCALSKILLS_API_TOKEN", "")
+	resp := configureProviderWithSettings(t, "", "lsk_test123", true, false, map[string]tftypes.Value{
+		"max_token_lifetime_days": tftypes.NewValue(tftypes.Number, 90),
+	})
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %s", resp.Diagnostics)
+	}
+
+	data, ok := resp.ResourceData.(*providerdata.ResourceData)
+	if !ok {
+		t.Fatalf("expected ResourceData to be *providerdata.ResourceData, got %T", resp.ResourceData)
+	}
+	if data.TokenPolicy.MaxLifetimeDays != 90 {
+		t.Errorf("expected MaxLifetimeDays 90, got %d", data.TokenPolicy.MaxLifetimeDays)
+	}
+}
+
+func TestProvider_TokenExpirySettings(t *testing.T) {
+	t.Setenv("LOCALSKILLS_API_TOKEN", "")
+
+	cases := map[string]struct {
+		settings        map[string]tftypes.Value
+		wantWarningDays int
+		wantError       bool
+	}{
+		"defaults": {
+			wantWarningDays: 14,
+		},
+		"configured": {
+			settings: map[string]tftypes.Value{
+				"token_expiry_warning_days": tftypes.NewValue(tftypes.Number, 30),
+				"error_on_expired_tokens":   tftypes.NewValue(tftypes.Bool, true),
+			},
+			wantWarningDays: 30,
+			wantError:       true,
+		},
+		"warning disabled": {
+			settings: map[string]tftypes.Value{
+				"token_expiry_warning_days": tftypes.NewValue(tftypes.Number, 0),
+			},
+			wantWarningDays: 0,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp := configureProviderWithSettings(t, "", "lsk_test123", true, false, tc.settings)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %s", resp.Diagnostics)
+			}
+
+			policy := resp.ResourceData.(*providerdata.ResourceData).TokenPolicy
+			if policy.ExpiryWarningDays != tc.wantWarningDays {
+				t.Errorf("expected ExpiryWarningDays %d, got %d", tc.wantWarningDays, policy.ExpiryWarningDays)
+			}
+			if policy.ErrorOnExpired != tc.wantError {
+				t.Errorf("expected ErrorOnExpired %t, got %t", tc.wantError, policy.ErrorOnExpired)
+			}
+		})
+	}
+}
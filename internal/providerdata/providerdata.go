@@ -0,0 +1,16 @@
+// Package providerdata holds what the provider hands to its resources when
+// they are configured.
+package providerdata
+
+import (
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/tokenlifecycle"
+)
+
+// ResourceData is the provider data of every resource.
+type ResourceData struct {
+	Client *client.Client
+
+	// TokenPolicy is the token lifecycle policy set on the provider.
+	TokenPolicy tokenlifecycle.Policy
+}
@@ -0,0 +1,95 @@
+package oidc_trust_policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+)
+
+var (
+	_ list.ListResource              = &OidcTrustPolicyListResource{}
+	_ list.ListResourceWithConfigure = &OidcTrustPolicyListResource{}
+)
+
+type OidcTrustPolicyListResource struct {
+	client *client.Client
+}
+
+type OidcTrustPolicyListModel struct {
+	TenantID types.String `tfsdk:"tenant_id"`
+}
+
+func NewListResource() list.ListResource {
+	return &OidcTrustPolicyListResource{}
+}
+
+func (r *OidcTrustPolicyListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oidc_trust_policy"
+}
+
+func (r *OidcTrustPolicyListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists OIDC trust policies of a team so they can be discovered with `terraform query` and imported.",
+		Attributes: map[string]schema.Attribute{
+			"tenant_id": schema.StringAttribute{
+				Description: "The tenant (team) ID to list OIDC trust policies for.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *OidcTrustPolicyListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+func (r *OidcTrustPolicyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config OidcTrustPolicyListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	policies, err := r.client.ListOIDCPolicies(ctx, config.TenantID.ValueString())
+	if err != nil {
+		diags.AddError("Error listing OIDC trust policies", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i := range policies {
+			result := req.NewListResult(ctx)
+			result.DisplayName = policies[i].Name
+			result.Diagnostics.Append(result.Identity.Set(ctx, OidcTrustPolicyIdentityModel{TenantID: config.TenantID, ID: types.StringValue(policies[i].ID)})...)
+
+			if req.IncludeResource {
+				state := OidcTrustPolicyModel{SkillSelector: types.ObjectNull(SkillSelectorAttrTypes)}
+				mapPolicyToState(ctx, &policies[i], &state, &result.Diagnostics)
+				state.TenantID = config.TenantID
+				result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
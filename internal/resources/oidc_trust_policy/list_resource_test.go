@@ -0,0 +1,46 @@
+package oidc_trust_policy_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/resources/oidc_trust_policy"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
+)
+
+func TestOidcTrustPolicyListResource_list(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/tenants/tenant-1/oidc-policies", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{
+				"id":         "pol-1",
+				"tenantId":   "tenant-1",
+				"name":       "deploy",
+				"provider":   "github",
+				"repository": "acme/app",
+				"refFilter":  "refs/heads/main",
+				"skillIds":   []string{"skill-1"},
+				"enabled":    true,
+			},
+		})
+	})
+
+	results := testutils.ListAll(t, oidc_trust_policy.NewListResource(), oidc_trust_policy.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+	}, true)
+
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	if results[0].DisplayName != "deploy" {
+		t.Errorf("expected display name 'deploy', got '%s'", results[0].DisplayName)
+	}
+
+	testutils.CheckStringAttribute(t, results[0].Identity.GetAttribute, "id", "pol-1")
+	testutils.CheckStringAttribute(t, results[0].Resource.GetAttribute, "repository", "acme/app")
+	testutils.CheckStringAttribute(t, results[0].Resource.GetAttribute, "oidc_provider", "github")
+}
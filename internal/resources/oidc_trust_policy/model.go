@@ -1,6 +1,7 @@
 package oidc_trust_policy
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -9,12 +10,46 @@ type OidcTrustPolicyModel struct {
 	TenantID          types.String `tfsdk:"tenant_id"`
 	Name              types.String `tfsdk:"name"`
 	Provider          types.String `tfsdk:"oidc_provider"`
+	IssuerURL         types.String `tfsdk:"issuer_url"`
+	Audience          types.String `tfsdk:"audience"`
 	Repository        types.String `tfsdk:"repository"`
 	RefFilter         types.String `tfsdk:"ref_filter"`
 	EnvironmentFilter types.String `tfsdk:"environment_filter"`
+	ClaimConditions   types.List   `tfsdk:"claim_conditions"`
 	SkillIDs          types.List   `tfsdk:"skill_ids"`
+	SkillSelector     types.Object `tfsdk:"skill_selector"`
+	ResolvedSkillIDs  types.List   `tfsdk:"resolved_skill_ids"`
 	Enabled           types.Bool   `tfsdk:"enabled"`
 	CreatedBy         types.String `tfsdk:"created_by"`
 	CreatedAt         types.String `tfsdk:"created_at"`
 	UpdatedAt         types.String `tfsdk:"updated_at"`
 }
+
+type OidcTrustPolicyIdentityModel struct {
+	TenantID types.String `tfsdk:"tenant_id"`
+	ID       types.String `tfsdk:"id"`
+}
+
+type ClaimConditionModel struct {
+	Claim    types.String `tfsdk:"claim"`
+	Operator types.String `tfsdk:"operator"`
+	Values   types.List   `tfsdk:"values"`
+}
+
+var ClaimConditionAttrTypes = map[string]attr.Type{
+	"claim":    types.StringType,
+	"operator": types.StringType,
+	"values":   types.ListType{ElemType: types.StringType},
+}
+
+type SkillSelectorModel struct {
+	Tags       types.Set    `tfsdk:"tags"`
+	Type       types.String `tfsdk:"type"`
+	Visibility types.String `tfsdk:"visibility"`
+}
+
+var SkillSelectorAttrTypes = map[string]attr.Type{
+	"tags":       types.SetType{ElemType: types.StringType},
+	"type":       types.StringType,
+	"visibility": types.StringType,
+}
@@ -3,12 +3,18 @@ package oidc_trust_policy
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -16,14 +22,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/oidcpolicy"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/providerdata"
 
 	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 )
 
 var (
-	_ resource.Resource                = &OidcTrustPolicyResource{}
-	_ resource.ResourceWithImportState = &OidcTrustPolicyResource{}
+	_ resource.Resource                   = &OidcTrustPolicyResource{}
+	_ resource.ResourceWithImportState    = &OidcTrustPolicyResource{}
+	_ resource.ResourceWithIdentity       = &OidcTrustPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &OidcTrustPolicyResource{}
+	_ resource.ResourceWithModifyPlan     = &OidcTrustPolicyResource{}
 )
 
 type OidcTrustPolicyResource struct {
@@ -40,7 +52,7 @@ func (r *OidcTrustPolicyResource) Metadata(_ context.Context, req resource.Metad
 
 func (r *OidcTrustPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages an OIDC trust policy for a team on localskills.sh. OIDC trust policies allow CI/CD pipelines (GitHub Actions, GitLab CI) to authenticate using OpenID Connect tokens and exchange them for short-lived localskills.sh API tokens.",
+		MarkdownDescription: "Manages an OIDC trust policy for a team on localskills.sh. OIDC trust policies allow CI/CD pipelines (GitHub Actions, GitLab CI, Buildkite, CircleCI, Bitbucket Pipelines or any other OIDC issuer) to authenticate using OpenID Connect tokens and exchange them for short-lived localskills.sh API tokens.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The unique identifier of the OIDC trust policy.",
@@ -61,18 +73,26 @@ func (r *OidcTrustPolicyResource) Schema(_ context.Context, _ resource.SchemaReq
 				Required:    true,
 			},
 			"oidc_provider": schema.StringAttribute{
-				Description: "The OIDC provider. Must be one of: github, gitlab.",
+				Description: "The OIDC provider. Must be one of: " + strings.Join(client.OidcProviders, ", ") + ".",
 				Required:    true,
 				Validators: []validator.String{
-					frameworkvalidator.OneOf("github", "gitlab"),
+					frameworkvalidator.OneOf(client.OidcProviders...),
 				},
 			},
+			"issuer_url": schema.StringAttribute{
+				Description: "The HTTPS URL of the OIDC issuer. Required when oidc_provider is custom, and not allowed otherwise.",
+				Optional:    true,
+			},
+			"audience": schema.StringAttribute{
+				Description: "The audience (aud claim) the issuer puts in its tokens. Required when oidc_provider is custom, and not allowed otherwise.",
+				Optional:    true,
+			},
 			"repository": schema.StringAttribute{
-				Description: "The repository identifier (e.g., 'org/repo').",
+				Description: "The repository identifier. Written as owner/repo for github, group/project or group/subgroup/project for gitlab, organization-slug/pipeline-slug for buildkite, workspace/repo-slug for bitbucket, and a project ID or org/repo for circleci.",
 				Required:    true,
 			},
 			"ref_filter": schema.StringAttribute{
-				Description: "Git ref filter pattern. Defaults to '*' (all refs).",
+				Description: "Git ref glob pattern. * matches any run of characters, ? a single character and [...] one character of a set. Defaults to '*' (all refs).",
 				Optional:    true,
 				Computed:    true,
 				Default:     stringdefault.StaticString("*"),
@@ -81,11 +101,84 @@ func (r *OidcTrustPolicyResource) Schema(_ context.Context, _ resource.SchemaReq
 				Description: "Environment filter for the policy.",
 				Optional:    true,
 			},
+			"claim_conditions": schema.ListNestedAttribute{
+				Description: "Further conditions on the claims of the OIDC token. Every condition must hold for the policy to match.",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"claim": schema.StringAttribute{
+							Description: "The name of the claim, such as job_workflow_ref, actor or runner_environment.",
+							Required:    true,
+							Validators: []validator.String{
+								frameworkvalidator.LengthAtLeast(1),
+							},
+						},
+						"operator": schema.StringAttribute{
+							Description: "How the claim is compared with values. in and not_in compare exactly, glob and not_glob match glob patterns. Must be one of: " + strings.Join(client.OidcClaimOperators, ", ") + ".",
+							Required:    true,
+							Validators: []validator.String{
+								frameworkvalidator.OneOf(client.OidcClaimOperators...),
+							},
+						},
+						"values": schema.ListAttribute{
+							Description: "The values or patterns to compare the claim with. The in and glob operators need the claim to match at least one, not_in and not_glob need it to match none.",
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
 			"skill_ids": schema.ListAttribute{
 				Description: "List of skill IDs that this policy grants access to.",
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"skill_selector": schema.SingleNestedAttribute{
+				Description: "Grants the skills of the team that match every criterion set, as an alternative to listing skill_ids. The selector is resolved into resolved_skill_ids on every plan, so newly matching skills are picked up by the next apply. Conflicts with skill_ids.",
+				Optional:    true,
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("skill_ids")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"tags": schema.SetAttribute{
+						Description: "Only select skills that have all of these tags.",
+						Optional:    true,
+						ElementType: types.StringType,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.AtLeastOneOf(
+								path.MatchRelative().AtParent().AtName("type"),
+								path.MatchRelative().AtParent().AtName("visibility"),
+							),
+						},
+					},
+					"type": schema.StringAttribute{
+						Description: "Only select skills of this type: skill or rule.",
+						Optional:    true,
+						Validators: []validator.String{
+							frameworkvalidator.OneOf("skill", "rule"),
+						},
+					},
+					"visibility": schema.StringAttribute{
+						Description: "Only select skills with this visibility: public, private or unlisted.",
+						Optional:    true,
+						Validators: []validator.String{
+							frameworkvalidator.OneOf("public", "private", "unlisted"),
+						},
+					},
+				},
+			},
+			"resolved_skill_ids": schema.ListAttribute{
+				Description: "The skills skill_selector currently selects, sorted by ID. Null when skill_selector is not set.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"enabled": schema.BoolAttribute{
 				Description: "Whether the policy is enabled. Defaults to true.",
 				Optional:    true,
@@ -114,43 +207,311 @@ func (r *OidcTrustPolicyResource) Schema(_ context.Context, _ resource.SchemaReq
 	}
 }
 
+func (r *OidcTrustPolicyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"tenant_id": identityschema.StringAttribute{
+				Description:       "The ID of the team (tenant) this policy belongs to.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the OIDC trust policy.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *OidcTrustPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerdata.ResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ResourceData, got: %T", req.ProviderData),
 		)
 		return
 	}
-	r.client = c
+	r.client = data.Client
 }
 
-func (r *OidcTrustPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+func (r *OidcTrustPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config OidcTrustPolicyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateGlobs(ctx, config, &resp.Diagnostics)
+	if config.Provider.IsUnknown() {
+		return
+	}
+
+	if !config.Repository.IsNull() && !config.Repository.IsUnknown() {
+		if err := oidcpolicy.ValidateRepository(config.Provider.ValueString(), config.Repository.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("repository"), "Invalid Repository", err.Error()+".")
+		}
+	}
+
+	// GitHub puts the full ref name in its tokens, so a short branch name
+	// never matches
+	refFilter := config.RefFilter.ValueString()
+	if config.Provider.ValueString() == "github" && !config.RefFilter.IsNull() && !config.RefFilter.IsUnknown() &&
+		!strings.HasPrefix(refFilter, "refs/") && !strings.HasPrefix(refFilter, "*") {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("ref_filter"),
+			"Ref Filter Never Matches",
+			fmt.Sprintf("GitHub tokens carry full ref names such as refs/heads/main, so ref_filter %q matches nothing. Did you mean %q?", refFilter, "refs/heads/"+refFilter),
+		)
+	}
+
+	custom := config.Provider.ValueString() == "custom"
+	settings := []struct {
+		name  string
+		value types.String
+	}{
+		{"issuer_url", config.IssuerURL},
+		{"audience", config.Audience},
+	}
+	for _, setting := range settings {
+		name, value := setting.name, setting.value
+		switch {
+		case custom && value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Custom Issuer Setting",
+				fmt.Sprintf("%s is required when oidc_provider is custom.", name),
+			)
+		case !custom && !value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Unexpected Issuer Setting",
+				fmt.Sprintf("%s can only be set when oidc_provider is custom. The %s issuer is known to localskills.sh.", name, config.Provider.ValueString()),
+			)
+		}
+	}
+
+	if !config.IssuerURL.IsNull() && !config.IssuerURL.IsUnknown() {
+		u, err := url.Parse(config.IssuerURL.ValueString())
+		if err != nil || u.Scheme != "https" || u.Host == "" || u.RawQuery != "" || u.Fragment != "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("issuer_url"),
+				"Invalid Issuer URL",
+				fmt.Sprintf("issuer_url must be an https URL without a query or fragment, such as https://token.example.com, got %q.", config.IssuerURL.ValueString()),
+			)
+		}
+	}
+}
+
+// ModifyPlan resolves skill_selector into resolved_skill_ids. Otherwise it
+// checks that every skill in skill_ids belongs to tenant_id, which the API
+// would accept and silently grant nothing for. Those skills are only looked
+// up when skill_ids or tenant_id change.
+func (r *OidcTrustPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
 	var plan OidcTrustPolicyModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var skillIDs []string
-	if !plan.SkillIDs.IsNull() && !plan.SkillIDs.IsUnknown() {
-		resp.Diagnostics.Append(plan.SkillIDs.ElementsAs(ctx, &skillIDs, false)...)
-		if resp.Diagnostics.HasError() {
+	if plan.SkillSelector.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("resolved_skill_ids"), types.ListNull(types.StringType))...)
+	}
+
+	// The remaining checks need the API
+	if r.client == nil || plan.TenantID.IsUnknown() {
+		return
+	}
+	if !plan.SkillSelector.IsNull() {
+		skillIDs := r.resolveSkillSelector(ctx, plan.TenantID.ValueString(), plan.SkillSelector, &resp.Diagnostics)
+		if skillIDs == nil {
 			return
 		}
+		resolved, diags := types.ListValueFrom(ctx, types.StringType, skillIDs)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("resolved_skill_ids"), resolved)...)
+		return
+	}
+	if plan.SkillIDs.IsNull() || plan.SkillIDs.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state OidcTrustPolicyModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || (state.TenantID.Equal(plan.TenantID) && state.SkillIDs.Equal(plan.SkillIDs)) {
+			return
+		}
+	}
+
+	tenantID := plan.TenantID.ValueString()
+	for i, v := range plan.SkillIDs.Elements() {
+		skillID, ok := v.(types.String)
+		if !ok || skillID.IsNull() || skillID.IsUnknown() {
+			continue
+		}
+
+		skill, err := r.client.GetSkill(ctx, skillID.ValueString())
+		if err != nil {
+			if client.IsNotFound(err) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("skill_ids").AtListIndex(i),
+					"Unknown Skill",
+					fmt.Sprintf("Skill %q does not exist or is not visible to the authenticated user.", skillID.ValueString()),
+				)
+				continue
+			}
+			resp.Diagnostics.AddError("Error reading skill", err.Error())
+			return
+		}
+		if skill.TenantID != tenantID {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("skill_ids").AtListIndex(i),
+				"Skill From Another Team",
+				fmt.Sprintf("Skill %q (%s) belongs to team %q, but this policy is for team %q. A trust policy can only grant the skills of its own team.", skillID.ValueString(), skill.Name, skill.TenantID, tenantID),
+			)
+		}
+	}
+}
+
+// resolveSkillSelector returns the IDs of the skills of tenantID matching
+// selector, sorted. An empty result is an error, as a policy without skills
+// grants every skill of the team. It returns nil without diagnostics while
+// part of the selector is unknown.
+func (r *OidcTrustPolicyResource) resolveSkillSelector(ctx context.Context, tenantID string, selectorValue types.Object, diags *diag.Diagnostics) []string {
+	if selectorValue.IsUnknown() {
+		return nil
+	}
+	var selector SkillSelectorModel
+	diags.Append(selectorValue.As(ctx, &selector, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() || selector.Tags.IsUnknown() || selector.Type.IsUnknown() || selector.Visibility.IsUnknown() {
+		return nil
+	}
+	var tags []string
+	diags.Append(selector.Tags.ElementsAs(ctx, &tags, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	skills, err := r.client.ListSkills(ctx, map[string]string{"tenant_id": tenantID})
+	if err != nil {
+		diags.AddError("Error resolving skill selector", err.Error())
+		return nil
+	}
+
+	var skillIDs []string
+	for _, skill := range skills {
+		if skill.TenantID == tenantID && selectorMatches(selector, tags, skill) {
+			skillIDs = append(skillIDs, skill.ID)
+		}
+	}
+	if len(skillIDs) == 0 {
+		diags.AddAttributeError(
+			path.Root("skill_selector"),
+			"Skill Selector Matches No Skills",
+			fmt.Sprintf("No skill of team %q matches skill_selector. A trust policy without skills grants every skill of the team, so the selector has to match at least one.", tenantID),
+		)
+		return nil
+	}
+	slices.Sort(skillIDs)
+	return skillIDs
+}
+
+func selectorMatches(selector SkillSelectorModel, tags []string, skill client.Skill) bool {
+	if !selector.Type.IsNull() && skill.Type != selector.Type.ValueString() {
+		return false
+	}
+	if !selector.Visibility.IsNull() && skill.Visibility != selector.Visibility.ValueString() {
+		return false
+	}
+	for _, tag := range tags {
+		if !slices.Contains(skill.Tags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// validateGlobs checks the glob syntax of ref_filter, environment_filter and
+// the values of glob claim conditions.
+func validateGlobs(ctx context.Context, config OidcTrustPolicyModel, diags *diag.Diagnostics) {
+	filters := []struct {
+		name  string
+		value types.String
+	}{
+		{"ref_filter", config.RefFilter},
+		{"environment_filter", config.EnvironmentFilter},
+	}
+	for _, filter := range filters {
+		name, value := filter.name, filter.value
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if _, err := oidcpolicy.CompileGlob(value.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root(name), "Invalid Glob Pattern", fmt.Sprintf("%s %q is not a valid glob: %s.", name, value.ValueString(), err))
+		}
+	}
+
+	if config.ClaimConditions.IsNull() || config.ClaimConditions.IsUnknown() {
+		return
+	}
+	var conditions []ClaimConditionModel
+	diags.Append(config.ClaimConditions.ElementsAs(ctx, &conditions, false)...)
+	for i, c := range conditions {
+		operator := c.Operator.ValueString()
+		if (operator != "glob" && operator != "not_glob") || c.Values.IsNull() || c.Values.IsUnknown() {
+			continue
+		}
+		for j, v := range c.Values.Elements() {
+			value, ok := v.(types.String)
+			if !ok || value.IsNull() || value.IsUnknown() {
+				continue
+			}
+			if _, err := oidcpolicy.CompileGlob(value.ValueString()); err != nil {
+				diags.AddAttributeError(
+					path.Root("claim_conditions").AtListIndex(i).AtName("values").AtListIndex(j),
+					"Invalid Glob Pattern",
+					fmt.Sprintf("%q is not a valid glob: %s.", value.ValueString(), err),
+				)
+			}
+		}
+	}
+}
+
+func (r *OidcTrustPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OidcTrustPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	skillIDs := r.plannedSkillIDs(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	claimConditions := expandClaimConditions(ctx, plan.ClaimConditions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	createReq := client.CreateOidcPolicyRequest{
-		Name:       plan.Name.ValueString(),
-		Provider:   plan.Provider.ValueString(),
-		Repository: plan.Repository.ValueString(),
-		RefFilter:  plan.RefFilter.ValueString(),
-		SkillIDs:   skillIDs,
-		Enabled:    plan.Enabled.ValueBool(),
+		Name:            plan.Name.ValueString(),
+		Provider:        plan.Provider.ValueString(),
+		IssuerURL:       plan.IssuerURL.ValueStringPointer(),
+		Audience:        plan.Audience.ValueStringPointer(),
+		Repository:      plan.Repository.ValueString(),
+		RefFilter:       plan.RefFilter.ValueString(),
+		ClaimConditions: claimConditions,
+		SkillIDs:        skillIDs,
+		Enabled:         plan.Enabled.ValueBool(),
 	}
 	if !plan.EnvironmentFilter.IsNull() && !plan.EnvironmentFilter.IsUnknown() {
 		envFilter := plan.EnvironmentFilter.ValueString()
@@ -165,6 +526,7 @@ func (r *OidcTrustPolicyResource) Create(ctx context.Context, req resource.Creat
 
 	mapPolicyToState(ctx, policy, &plan, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, OidcTrustPolicyIdentityModel{TenantID: plan.TenantID, ID: plan.ID})...)
 }
 
 func (r *OidcTrustPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -174,31 +536,19 @@ func (r *OidcTrustPolicyResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	policies, err := r.client.ListOIDCPolicies(ctx, state.TenantID.ValueString())
+	found, err := r.client.GetOIDCPolicy(ctx, state.TenantID.ValueString(), state.ID.ValueString())
 	if err != nil {
 		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error reading OIDC trust policies", err.Error())
-		return
-	}
-
-	var found *client.OidcTrustPolicy
-	for i := range policies {
-		if policies[i].ID == state.ID.ValueString() {
-			found = &policies[i]
-			break
-		}
-	}
-
-	if found == nil {
-		resp.State.RemoveResource(ctx)
+		resp.Diagnostics.AddError("Error reading OIDC trust policy", err.Error())
 		return
 	}
 
 	mapPolicyToState(ctx, found, &state, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, OidcTrustPolicyIdentityModel{TenantID: state.TenantID, ID: state.ID})...)
 }
 
 func (r *OidcTrustPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -220,12 +570,23 @@ func (r *OidcTrustPolicyResource) Update(ctx context.Context, req resource.Updat
 	refFilter := plan.RefFilter.ValueString()
 	enabled := plan.Enabled.ValueBool()
 
+	claimConditions := expandClaimConditions(ctx, plan.ClaimConditions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if claimConditions == nil {
+		claimConditions = []client.OidcClaimCondition{}
+	}
+
 	updateReq := client.UpdateOidcPolicyRequest{
-		Name:       &name,
-		Provider:   &provider,
-		Repository: &repository,
-		RefFilter:  &refFilter,
-		Enabled:    &enabled,
+		Name:            &name,
+		Provider:        &provider,
+		IssuerURL:       clearableString(plan.IssuerURL),
+		Audience:        clearableString(plan.Audience),
+		Repository:      &repository,
+		RefFilter:       &refFilter,
+		ClaimConditions: &claimConditions,
+		Enabled:         &enabled,
 	}
 
 	if !plan.EnvironmentFilter.IsNull() && !plan.EnvironmentFilter.IsUnknown() {
@@ -233,14 +594,14 @@ func (r *OidcTrustPolicyResource) Update(ctx context.Context, req resource.Updat
 		updateReq.EnvironmentFilter = &envFilter
 	}
 
-	if !plan.SkillIDs.IsNull() && !plan.SkillIDs.IsUnknown() {
-		var skillIDs []string
-		resp.Diagnostics.Append(plan.SkillIDs.ElementsAs(ctx, &skillIDs, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		updateReq.SkillIDs = skillIDs
+	skillIDs := r.plannedSkillIDs(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	if skillIDs == nil {
+		skillIDs = []string{}
+	}
+	updateReq.SkillIDs = &skillIDs
 
 	policy, err := r.client.UpdateOIDCPolicy(ctx, plan.TenantID.ValueString(), state.ID.ValueString(), updateReq)
 	if err != nil {
@@ -250,6 +611,7 @@ func (r *OidcTrustPolicyResource) Update(ctx context.Context, req resource.Updat
 
 	mapPolicyToState(ctx, policy, &plan, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, OidcTrustPolicyIdentityModel{TenantID: plan.TenantID, ID: plan.ID})...)
 }
 
 func (r *OidcTrustPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -269,6 +631,17 @@ func (r *OidcTrustPolicyResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *OidcTrustPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity OidcTrustPolicyIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tenant_id"), identity.TenantID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+		return
+	}
+
 	parts := strings.SplitN(req.ID, "/", 2)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		resp.Diagnostics.AddError(
@@ -294,21 +667,106 @@ func mapPolicyToState(ctx context.Context, policy *client.OidcTrustPolicy, state
 	state.CreatedAt = types.StringValue(policy.CreatedAt)
 	state.UpdatedAt = types.StringValue(policy.UpdatedAt)
 
+	state.IssuerURL = stringOrNull(policy.IssuerURL)
+	state.Audience = stringOrNull(policy.Audience)
+
 	if policy.EnvironmentFilter != nil {
 		state.EnvironmentFilter = types.StringValue(*policy.EnvironmentFilter)
 	} else {
 		state.EnvironmentFilter = types.StringNull()
 	}
 
+	state.ClaimConditions = flattenClaimConditions(ctx, policy.ClaimConditions, diags)
+
+	state.SkillIDs = types.ListNull(types.StringType)
+	state.ResolvedSkillIDs = types.ListNull(types.StringType)
 	if len(policy.SkillIDs) > 0 {
-		skillIDValues := make([]attr.Value, len(policy.SkillIDs))
-		for i, id := range policy.SkillIDs {
+		skillIDs := policy.SkillIDs
+		target := &state.SkillIDs
+		// Skills granted through skill_selector are reported as resolved_skill_ids
+		if !state.SkillSelector.IsNull() {
+			skillIDs = slices.Sorted(slices.Values(policy.SkillIDs))
+			target = &state.ResolvedSkillIDs
+		}
+		skillIDValues := make([]attr.Value, len(skillIDs))
+		for i, id := range skillIDs {
 			skillIDValues[i] = types.StringValue(id)
 		}
 		skillIDsList, d := types.ListValue(types.StringType, skillIDValues)
 		diags.Append(d...)
-		state.SkillIDs = skillIDsList
-	} else {
-		state.SkillIDs = types.ListNull(types.StringType)
+		*target = skillIDsList
+	}
+}
+
+// plannedSkillIDs returns the skills to send to the API: resolved_skill_ids
+// when skill_selector is set, skill_ids otherwise. The selector is resolved
+// here when it could not be at plan time, such as when tenant_id was unknown.
+func (r *OidcTrustPolicyResource) plannedSkillIDs(ctx context.Context, plan OidcTrustPolicyModel, diags *diag.Diagnostics) []string {
+	list := plan.SkillIDs
+	if !plan.SkillSelector.IsNull() {
+		if plan.ResolvedSkillIDs.IsUnknown() {
+			return r.resolveSkillSelector(ctx, plan.TenantID.ValueString(), plan.SkillSelector, diags)
+		}
+		list = plan.ResolvedSkillIDs
+	}
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+	var skillIDs []string
+	diags.Append(list.ElementsAs(ctx, &skillIDs, false)...)
+	return skillIDs
+}
+
+// clearableString returns the value to send in an update for an optional
+// string: a pointer to an empty string clears it on the server.
+func clearableString(v types.String) *string {
+	value := v.ValueString()
+	return &value
+}
+
+// stringOrNull treats an empty string from the API as unset.
+func stringOrNull(v *string) types.String {
+	if v == nil || *v == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*v)
+}
+
+func expandClaimConditions(ctx context.Context, list types.List, diags *diag.Diagnostics) []client.OidcClaimCondition {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	var models []ClaimConditionModel
+	diags.Append(list.ElementsAs(ctx, &models, false)...)
+	conditions := make([]client.OidcClaimCondition, len(models))
+	for i, m := range models {
+		conditions[i] = client.OidcClaimCondition{
+			Claim:    m.Claim.ValueString(),
+			Operator: m.Operator.ValueString(),
+		}
+		diags.Append(m.Values.ElementsAs(ctx, &conditions[i].Values, false)...)
+	}
+	return conditions
+}
+
+func flattenClaimConditions(ctx context.Context, conditions []client.OidcClaimCondition, diags *diag.Diagnostics) types.List {
+	objectType := types.ObjectType{AttrTypes: ClaimConditionAttrTypes}
+	if len(conditions) == 0 {
+		return types.ListNull(objectType)
+	}
+
+	models := make([]ClaimConditionModel, len(conditions))
+	for i, c := range conditions {
+		values, d := types.ListValueFrom(ctx, types.StringType, c.Values)
+		diags.Append(d...)
+		models[i] = ClaimConditionModel{
+			Claim:    types.StringValue(c.Claim),
+			Operator: types.StringValue(c.Operator),
+			Values:   values,
+		}
 	}
+	list, d := types.ListValueFrom(ctx, objectType, models)
+	diags.Append(d...)
+	return list
 }
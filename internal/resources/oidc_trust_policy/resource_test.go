@@ -1,12 +1,20 @@
 package oidc_trust_policy_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/resources/oidc_trust_policy"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
 )
 
@@ -93,6 +101,445 @@ func TestAccOidcTrustPolicy_import(t *testing.T) {
 	})
 }
 
+func TestOidcTrustPolicyResource_identityRoundTrip(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/tenants/tenant-1/oidc-policies", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "pol-1", "tenantId": "tenant-1", "name": "deploy", "provider": "github", "repository": "org/repo", "refFilter": "*", "enabled": true},
+		})
+	})
+
+	state, identity := testutils.ImportByIdentity(t, oidc_trust_policy.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+		"id":        tftypes.NewValue(tftypes.String, "pol-1"),
+	})
+
+	testutils.CheckStringAttribute(t, identity.GetAttribute, "tenant_id", "tenant-1")
+	testutils.CheckStringAttribute(t, identity.GetAttribute, "id", "pol-1")
+
+	testutils.CheckStringAttribute(t, state.GetAttribute, "id", "pol-1")
+	testutils.CheckStringAttribute(t, state.GetAttribute, "repository", "org/repo")
+}
+
+var conditionType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"claim":    tftypes.String,
+	"operator": tftypes.String,
+	"values":   tftypes.List{ElementType: tftypes.String},
+}}
+
+func TestOidcTrustPolicyResource_validateConfig(t *testing.T) {
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+
+	cases := map[string]struct {
+		attrs   map[string]tftypes.Value
+		wantErr bool
+	}{
+		"github": {
+			attrs: map[string]tftypes.Value{"oidc_provider": str("github")},
+		},
+		"custom": {
+			attrs: map[string]tftypes.Value{"oidc_provider": str("custom"), "issuer_url": str("https://token.example.com"), "audience": str("localskills")},
+		},
+		"custom without issuer_url": {
+			attrs:   map[string]tftypes.Value{"oidc_provider": str("custom"), "audience": str("localskills")},
+			wantErr: true,
+		},
+		"custom without audience": {
+			attrs:   map[string]tftypes.Value{"oidc_provider": str("custom"), "issuer_url": str("https://token.example.com")},
+			wantErr: true,
+		},
+		"issuer_url on a known provider": {
+			attrs:   map[string]tftypes.Value{"oidc_provider": str("buildkite"), "issuer_url": str("https://agent.buildkite.com")},
+			wantErr: true,
+		},
+		"github repository": {
+			attrs: map[string]tftypes.Value{"oidc_provider": str("github"), "repository": str("my-org/skills.repo")},
+		},
+		"github repository with a group": {
+			attrs:   map[string]tftypes.Value{"oidc_provider": str("github"), "repository": str("my-org/team/skills")},
+			wantErr: true,
+		},
+		"gitlab nested group": {
+			attrs: map[string]tftypes.Value{"oidc_provider": str("gitlab"), "repository": str("my-org/team/skills")},
+		},
+		"gitlab repository without a group": {
+			attrs:   map[string]tftypes.Value{"oidc_provider": str("gitlab"), "repository": str("skills")},
+			wantErr: true,
+		},
+		"custom repository": {
+			attrs: map[string]tftypes.Value{"oidc_provider": str("custom"), "issuer_url": str("https://token.example.com"), "audience": str("localskills"), "repository": str("anything goes")},
+		},
+		"invalid ref_filter": {
+			attrs:   map[string]tftypes.Value{"oidc_provider": str("github"), "ref_filter": str("refs/heads/[main")},
+			wantErr: true,
+		},
+		"invalid glob claim condition": {
+			attrs: map[string]tftypes.Value{
+				"oidc_provider": str("github"),
+				"claim_conditions": tftypes.NewValue(tftypes.List{ElementType: conditionType}, []tftypes.Value{
+					tftypes.NewValue(conditionType, map[string]tftypes.Value{
+						"claim":    str("actor"),
+						"operator": str("not_glob"),
+						"values":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{str(`bot\`)}),
+					}),
+				}),
+			},
+			wantErr: true,
+		},
+		"plain http issuer_url": {
+			attrs:   map[string]tftypes.Value{"oidc_provider": str("custom"), "issuer_url": str("http://token.example.com"), "audience": str("localskills")},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diags := testutils.ValidateConfig(t, oidc_trust_policy.NewResource(), nil, tc.attrs)
+			if diags.HasError() != tc.wantErr {
+				t.Errorf("expected error %t, got %s", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestOidcTrustPolicyResource_refFilterWarning(t *testing.T) {
+	diags := testutils.ValidateConfig(t, oidc_trust_policy.NewResource(), nil, map[string]tftypes.Value{
+		"oidc_provider": tftypes.NewValue(tftypes.String, "github"),
+		"ref_filter":    tftypes.NewValue(tftypes.String, "main"),
+	})
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Errorf("expected a single warning, got %s", diags)
+	}
+}
+
+func TestOidcTrustPolicyResource_skillTenant(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/tenants/tenant-1/oidc-policies", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "pol-1", "tenantId": "tenant-1", "name": "deploy", "provider": "github", "repository": "org/repo", "refFilter": "*", "skillIds": []string{"sk-own"}, "enabled": true},
+		})
+	})
+	mux.HandleFunc("/api/skills/sk-own", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, map[string]interface{}{"id": "sk-own", "tenantId": "tenant-1", "name": "own"})
+	})
+	mux.HandleFunc("/api/skills/sk-other", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, map[string]interface{}{"id": "sk-other", "tenantId": "tenant-2", "name": "other"})
+	})
+	mux.HandleFunc("/api/skills/sk-missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]interface{}{"success": false, "error": "Skill not found"})
+	})
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	state, _ := testutils.ImportByIdentity(t, oidc_trust_policy.NewResource(), c, map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+		"id":        tftypes.NewValue(tftypes.String, "pol-1"),
+	})
+
+	skillIDs := func(ids ...string) map[string]tftypes.Value {
+		values := make([]tftypes.Value, len(ids))
+		for i, id := range ids {
+			values[i] = tftypes.NewValue(tftypes.String, id)
+		}
+		return map[string]tftypes.Value{"skill_ids": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, values)}
+	}
+
+	resp := testutils.ModifyPlan(t, oidc_trust_policy.NewResource(), c, state, skillIDs("sk-own"))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %s", resp.Diagnostics)
+	}
+
+	resp = testutils.ModifyPlan(t, oidc_trust_policy.NewResource(), c, state, skillIDs("sk-own", "sk-other", "sk-missing"))
+	var summaries []string
+	for _, d := range resp.Diagnostics.Errors() {
+		summaries = append(summaries, d.Summary())
+	}
+	if len(summaries) != 2 || summaries[0] != "Skill From Another Team" || summaries[1] != "Unknown Skill" {
+		t.Errorf("expected errors for sk-other and sk-missing, got %s", resp.Diagnostics)
+	}
+}
+
+func TestOidcTrustPolicyResource_claimConditions(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	var created client.CreateOidcPolicyRequest
+	mux.HandleFunc("/api/tenants/tenant-1/oidc-policies", func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		testutils.RespondData(w, client.OidcTrustPolicy{
+			ID: "pol-1", TenantID: "tenant-1", Name: created.Name, Provider: created.Provider,
+			IssuerURL: created.IssuerURL, Audience: created.Audience, Repository: created.Repository,
+			RefFilter: created.RefFilter, ClaimConditions: created.ClaimConditions, Enabled: created.Enabled,
+		})
+	})
+
+	state, diags := testutils.Create(t, oidc_trust_policy.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"tenant_id":          tftypes.NewValue(tftypes.String, "tenant-1"),
+		"name":               tftypes.NewValue(tftypes.String, "deploy"),
+		"oidc_provider":      tftypes.NewValue(tftypes.String, "custom"),
+		"issuer_url":         tftypes.NewValue(tftypes.String, "https://token.example.com"),
+		"audience":           tftypes.NewValue(tftypes.String, "localskills"),
+		"repository":         tftypes.NewValue(tftypes.String, "org/repo"),
+		"ref_filter":         tftypes.NewValue(tftypes.String, "*"),
+		"environment_filter": tftypes.NewValue(tftypes.String, nil),
+		"skill_ids":          tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+		"enabled":            tftypes.NewValue(tftypes.Bool, true),
+		"claim_conditions": tftypes.NewValue(tftypes.List{ElementType: conditionType}, []tftypes.Value{
+			tftypes.NewValue(conditionType, map[string]tftypes.Value{
+				"claim":    tftypes.NewValue(tftypes.String, "actor"),
+				"operator": tftypes.NewValue(tftypes.String, "not_in"),
+				"values":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "dependabot[bot]")}),
+			}),
+		}),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+
+	if len(created.ClaimConditions) != 1 || created.ClaimConditions[0].Operator != "not_in" || created.ClaimConditions[0].Values[0] != "dependabot[bot]" {
+		t.Errorf("unexpected claim conditions sent: %+v", created.ClaimConditions)
+	}
+	if created.IssuerURL == nil || *created.IssuerURL != "https://token.example.com" {
+		t.Errorf("expected issuer URL to be sent, got %v", created.IssuerURL)
+	}
+
+	testutils.CheckStringAttribute(t, state.GetAttribute, "audience", "localskills")
+	var claim types.String
+	state.GetAttribute(context.Background(), path.Root("claim_conditions").AtListIndex(0).AtName("claim"), &claim)
+	if claim.ValueString() != "actor" {
+		t.Errorf("expected claim actor, got %s", claim)
+	}
+
+	// Switching away from a custom issuer clears issuer_url and audience. The
+	// mock API leaves fields missing from the update unchanged.
+	current := client.OidcTrustPolicy{
+		ID: "pol-1", TenantID: "tenant-1", Name: created.Name, Provider: created.Provider,
+		IssuerURL: created.IssuerURL, Audience: created.Audience, Repository: created.Repository,
+		RefFilter: created.RefFilter, ClaimConditions: created.ClaimConditions, Enabled: created.Enabled,
+	}
+	mux.HandleFunc("/api/tenants/tenant-1/oidc-policies/pol-1", func(w http.ResponseWriter, r *http.Request) {
+		var updated client.UpdateOidcPolicyRequest
+		if err := json.NewDecoder(r.Body).Decode(&updated); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if updated.Provider != nil {
+			current.Provider = *updated.Provider
+		}
+		if updated.IssuerURL != nil {
+			current.IssuerURL = updated.IssuerURL
+		}
+		if updated.Audience != nil {
+			current.Audience = updated.Audience
+		}
+		if updated.ClaimConditions != nil {
+			current.ClaimConditions = *updated.ClaimConditions
+		}
+		testutils.RespondData(w, current)
+	})
+
+	state, diags = testutils.Update(t, oidc_trust_policy.NewResource(), client.NewClient(server.URL, "lsk_test123"), state, map[string]tftypes.Value{
+		"oidc_provider": tftypes.NewValue(tftypes.String, "github"),
+		"issuer_url":    tftypes.NewValue(tftypes.String, nil),
+		"audience":      tftypes.NewValue(tftypes.String, nil),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+	for _, name := range []string{"issuer_url", "audience"} {
+		var value types.String
+		state.GetAttribute(context.Background(), path.Root(name), &value)
+		if !value.IsNull() {
+			t.Errorf("expected %s to be cleared, got %s", name, value)
+		}
+	}
+}
+
+func TestOidcTrustPolicyResource_skillSelector(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	var updated client.UpdateOidcPolicyRequest
+	mux.HandleFunc("/api/tenants/tenant-1/oidc-policies", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "pol-1", "tenantId": "tenant-1", "name": "deploy", "provider": "github", "repository": "org/repo", "refFilter": "*", "enabled": true},
+		})
+	})
+	mux.HandleFunc("/api/tenants/tenant-1/oidc-policies/pol-1", func(w http.ResponseWriter, r *http.Request) {
+		updated = client.UpdateOidcPolicyRequest{}
+		json.NewDecoder(r.Body).Decode(&updated)
+		testutils.RespondData(w, map[string]interface{}{"id": "pol-1", "tenantId": "tenant-1", "name": "deploy", "provider": "github", "repository": "org/repo", "refFilter": "*", "skillIds": updated.SkillIDs, "enabled": true})
+	})
+	handleSelectorSkills(t, mux)
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	state, _ := testutils.ImportByIdentity(t, oidc_trust_policy.NewResource(), c, map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+		"id":        tftypes.NewValue(tftypes.String, "pol-1"),
+	})
+
+	selectorType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"tags":       tftypes.Set{ElementType: tftypes.String},
+		"type":       tftypes.String,
+		"visibility": tftypes.String,
+	}}
+	selector := func(tag, skillType string) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"skill_selector": tftypes.NewValue(selectorType, map[string]tftypes.Value{
+				"tags":       tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, tag)}),
+				"type":       tftypes.NewValue(tftypes.String, skillType),
+				"visibility": tftypes.NewValue(tftypes.String, nil),
+			}),
+		}
+	}
+
+	resp := testutils.ModifyPlan(t, oidc_trust_policy.NewResource(), c, state, selector("ci", "rule"))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %s", resp.Diagnostics)
+	}
+	var resolved []string
+	resp.Plan.GetAttribute(context.Background(), path.Root("resolved_skill_ids"), &resolved)
+	if len(resolved) != 2 || resolved[0] != "sk-a" || resolved[1] != "sk-c" {
+		t.Fatalf("expected sk-a and sk-c to be selected, got %v", resolved)
+	}
+
+	resp = testutils.ModifyPlan(t, oidc_trust_policy.NewResource(), c, state, selector("deploy", "rule"))
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error for a selector that matches nothing")
+	}
+
+	// The resolved skills are sent and kept out of skill_ids
+	attrs := selector("ci", "rule")
+	attrs["resolved_skill_ids"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "sk-a"),
+		tftypes.NewValue(tftypes.String, "sk-c"),
+	})
+	state, diags := testutils.Update(t, oidc_trust_policy.NewResource(), c, state, attrs)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+	if updated.SkillIDs == nil || len(*updated.SkillIDs) != 2 {
+		t.Errorf("expected the resolved skills to be sent, got %v", updated.SkillIDs)
+	}
+	var skillIDs types.List
+	state.GetAttribute(context.Background(), path.Root("skill_ids"), &skillIDs)
+	state.GetAttribute(context.Background(), path.Root("resolved_skill_ids"), &resolved)
+	if !skillIDs.IsNull() || len(resolved) != 2 {
+		t.Errorf("expected only resolved_skill_ids to be set, got skill_ids %s and resolved_skill_ids %v", skillIDs, resolved)
+	}
+
+	// Removing the selector clears the skills rather than leaving them unchanged
+	state, diags = testutils.Update(t, oidc_trust_policy.NewResource(), c, state, map[string]tftypes.Value{
+		"skill_selector":     tftypes.NewValue(selectorType, nil),
+		"resolved_skill_ids": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+	if updated.SkillIDs == nil || len(*updated.SkillIDs) != 0 {
+		t.Errorf("expected an empty skill list to be sent, got %v", updated.SkillIDs)
+	}
+	state.GetAttribute(context.Background(), path.Root("skill_ids"), &skillIDs)
+	if !skillIDs.IsNull() {
+		t.Errorf("expected skill_ids to stay null, got %s", skillIDs)
+	}
+}
+
+func TestOidcTrustPolicyResource_skillSelectorUnknownTenant(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	var created *client.CreateOidcPolicyRequest
+	mux.HandleFunc("/api/tenants/tenant-1/oidc-policies", func(w http.ResponseWriter, r *http.Request) {
+		created = &client.CreateOidcPolicyRequest{}
+		json.NewDecoder(r.Body).Decode(created)
+		testutils.RespondData(w, map[string]interface{}{"id": "pol-1", "tenantId": "tenant-1", "name": created.Name, "provider": "github", "repository": "org/repo", "refFilter": "*", "skillIds": created.SkillIDs, "enabled": true})
+	})
+	handleSelectorSkills(t, mux)
+
+	selectorType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"tags":       tftypes.Set{ElementType: tftypes.String},
+		"type":       tftypes.String,
+		"visibility": tftypes.String,
+	}}
+	attrs := func(tag string) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"tenant_id":          tftypes.NewValue(tftypes.String, "tenant-1"),
+			"name":               tftypes.NewValue(tftypes.String, "deploy"),
+			"oidc_provider":      tftypes.NewValue(tftypes.String, "github"),
+			"issuer_url":         tftypes.NewValue(tftypes.String, nil),
+			"audience":           tftypes.NewValue(tftypes.String, nil),
+			"repository":         tftypes.NewValue(tftypes.String, "org/repo"),
+			"ref_filter":         tftypes.NewValue(tftypes.String, "*"),
+			"environment_filter": tftypes.NewValue(tftypes.String, nil),
+			"claim_conditions":   tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"claim": tftypes.String, "operator": tftypes.String, "values": tftypes.List{ElementType: tftypes.String}}}}, nil),
+			"skill_ids":          tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			"enabled":            tftypes.NewValue(tftypes.Bool, true),
+			"skill_selector": tftypes.NewValue(selectorType, map[string]tftypes.Value{
+				"tags":       tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, tag)}),
+				"type":       tftypes.NewValue(tftypes.String, "rule"),
+				"visibility": tftypes.NewValue(tftypes.String, nil),
+			}),
+		}
+	}
+	c := client.NewClient(server.URL, "lsk_test123")
+
+	// A team created in the same apply leaves tenant_id, and so
+	// resolved_skill_ids, unknown at plan time
+	unknownTenant := attrs("ci")
+	unknownTenant["tenant_id"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	plan := testutils.ModifyPlanForCreate(t, oidc_trust_policy.NewResource(), c, unknownTenant)
+	if plan.Diagnostics.HasError() {
+		t.Fatalf("unexpected plan errors: %s", plan.Diagnostics)
+	}
+	var resolved types.List
+	plan.Plan.GetAttribute(context.Background(), path.Root("resolved_skill_ids"), &resolved)
+	if !resolved.IsUnknown() {
+		t.Fatalf("expected resolved_skill_ids to be unknown, got %s", resolved)
+	}
+
+	state, diags := testutils.Create(t, oidc_trust_policy.NewResource(), c, attrs("ci"))
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+	if created == nil || len(created.SkillIDs) != 2 || created.SkillIDs[0] != "sk-a" || created.SkillIDs[1] != "sk-c" {
+		t.Fatalf("expected the selector to be resolved on create, got %v", created)
+	}
+	var skillIDs []string
+	state.GetAttribute(context.Background(), path.Root("resolved_skill_ids"), &skillIDs)
+	if len(skillIDs) != 2 {
+		t.Errorf("expected resolved_skill_ids to be set, got %v", skillIDs)
+	}
+
+	// A selector matching nothing must not create a policy granting every skill
+	created = nil
+	_, diags = testutils.Create(t, oidc_trust_policy.NewResource(), c, attrs("deploy"))
+	if !diags.HasError() {
+		t.Error("expected an error for a selector that matches nothing")
+	}
+	if created != nil {
+		t.Errorf("expected no policy to be created, got %v", created)
+	}
+}
+
+func handleSelectorSkills(t *testing.T, mux *http.ServeMux) {
+	mux.HandleFunc("/api/skills", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("tenant_id"); got != "tenant-1" {
+			t.Errorf("expected skills of tenant-1, got %q", got)
+		}
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "sk-c", "tenantId": "tenant-1", "type": "rule", "visibility": "private", "tags": []string{"ci", "lint"}},
+			{"id": "sk-a", "tenantId": "tenant-1", "type": "rule", "visibility": "public", "tags": []string{"ci"}},
+			{"id": "sk-b", "tenantId": "tenant-1", "type": "skill", "visibility": "private", "tags": []string{"ci"}},
+		})
+	})
+}
+
 func testAccOidcTrustPolicyImportStateIdFunc(s *terraform.State) (string, error) {
 	rs, ok := s.RootModule().Resources["localskills_oidc_trust_policy.test"]
 	if !ok {
@@ -13,4 +13,10 @@ type ScimTokenModel struct {
 	LastUsedAt    types.String `tfsdk:"last_used_at"`
 	ExpiresAt     types.String `tfsdk:"expires_at"`
 	CreatedAt     types.String `tfsdk:"created_at"`
+	Rotation      types.Object `tfsdk:"rotation"`
+}
+
+type ScimTokenIdentityModel struct {
+	TenantID types.String `tfsdk:"tenant_id"`
+	ID       types.String `tfsdk:"id"`
 }
@@ -4,24 +4,35 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/providerdata"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/tokenlifecycle"
 )
 
 var (
-	_ resource.Resource                = &scimTokenResource{}
-	_ resource.ResourceWithImportState = &scimTokenResource{}
+	_ resource.Resource                   = &scimTokenResource{}
+	_ resource.ResourceWithImportState    = &scimTokenResource{}
+	_ resource.ResourceWithIdentity       = &scimTokenResource{}
+	_ resource.ResourceWithModifyPlan     = &scimTokenResource{}
+	_ resource.ResourceWithValidateConfig = &scimTokenResource{}
 )
 
+// replaceAttributes are the attributes whose changes replace the token.
+var replaceAttributes = []string{"tenant_id", "name", "expires_in_days"}
+
 type scimTokenResource struct {
-	client *client.Client
+	client      *client.Client
+	tokenPolicy tokenlifecycle.Policy
 }
 
 func NewResource() resource.Resource {
@@ -87,6 +98,22 @@ func (r *scimTokenResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"rotation": tokenlifecycle.RotationAttribute(),
+		},
+	}
+}
+
+func (r *scimTokenResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"tenant_id": identityschema.StringAttribute{
+				Description:       "The tenant (team) ID.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the SCIM token.",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
@@ -95,15 +122,16 @@ func (r *scimTokenResource) Configure(_ context.Context, req resource.ConfigureR
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerdata.ResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ResourceData, got: %T", req.ProviderData),
 		)
 		return
 	}
-	r.client = c
+	r.client = data.Client
+	r.tokenPolicy = data.TokenPolicy
 }
 
 func (r *scimTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -139,6 +167,7 @@ func (r *scimTokenResource) Create(ctx context.Context, req resource.CreateReque
 	plan.LastUsedAt = types.StringNull()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ScimTokenIdentityModel{TenantID: plan.TenantID, ID: plan.ID})...)
 }
 
 func (r *scimTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -186,15 +215,53 @@ func (r *scimTokenResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Preserve token_value from state since API only returns hashes
 	state.TokenValue = currentState.TokenValue
+	state.Rotation = currentState.Rotation
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ScimTokenIdentityModel{TenantID: state.TenantID, ID: state.ID})...)
 }
 
-func (r *scimTokenResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"SCIM tokens cannot be updated. All attributes require replacement.",
-	)
+func (r *scimTokenResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ScimTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.tokenPolicy.ValidateLifetime(config.ExpiresInDays, types.StringNull(), time.Now())...)
+}
+
+func (r *scimTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ScimTokenModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenlifecycle.PlanRotation(ctx, plan.Rotation, state.CreatedAt, state.ExpiresAt, req, resp)
+	r.tokenPolicy.PlanExpiry(state.Name, state.ExpiresAt, replaceAttributes, req, resp)
+}
+
+func (r *scimTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ScimTokenModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every other attribute requires replacement, so only the rotation
+	// settings can change in place.
+	state.Rotation = plan.Rotation
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ScimTokenIdentityModel{TenantID: state.TenantID, ID: state.ID})...)
 }
 
 func (r *scimTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -214,6 +281,17 @@ func (r *scimTokenResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *scimTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity ScimTokenIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tenant_id"), identity.TenantID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+		return
+	}
+
 	parts := strings.SplitN(req.ID, "/", 2)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		resp.Diagnostics.AddError(
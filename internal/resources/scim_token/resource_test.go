@@ -1,11 +1,20 @@
 package scim_token_test
 
 import (
+	"context"
+	"net/http"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/resources/scim_token"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/tokenlifecycle"
 )
 
 func TestAccScimTokenResource_basic(t *testing.T) {
@@ -40,6 +49,183 @@ func TestAccScimTokenResource_basic(t *testing.T) {
 	})
 }
 
+func TestScimTokenResource_identityRoundTrip(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/tenants/tenant-1/scim-tokens", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "scim-1", "name": "okta", "createdAt": "2024-01-01T00:00:00Z"},
+		})
+	})
+
+	state, identity := testutils.ImportByIdentity(t, scim_token.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+		"id":        tftypes.NewValue(tftypes.String, "scim-1"),
+	})
+
+	testutils.CheckStringAttribute(t, identity.GetAttribute, "tenant_id", "tenant-1")
+	testutils.CheckStringAttribute(t, identity.GetAttribute, "id", "scim-1")
+
+	testutils.CheckStringAttribute(t, state.GetAttribute, "id", "scim-1")
+	testutils.CheckStringAttribute(t, state.GetAttribute, "name", "okta")
+}
+
+func TestScimTokenResource_rotation(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/tenants/tenant-1/scim-tokens", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "scim-1", "name": "okta", "createdAt": "2024-01-01T00:00:00Z", "expiresAt": time.Now().Add(10 * 24 * time.Hour).Format(time.RFC3339)},
+		})
+	})
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	state, _ := testutils.ImportByIdentity(t, scim_token.NewResource(), c, map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+		"id":        tftypes.NewValue(tftypes.String, "scim-1"),
+	})
+
+	rotationType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"rotate_after_days": tftypes.Number, "rotate_before_expiry_days": tftypes.Number}}
+	rotation := func(afterDays, beforeExpiryDays interface{}) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"rotation": tftypes.NewValue(rotationType, map[string]tftypes.Value{
+				"rotate_after_days":         tftypes.NewValue(tftypes.Number, afterDays),
+				"rotate_before_expiry_days": tftypes.NewValue(tftypes.Number, beforeExpiryDays),
+			}),
+		}
+	}
+
+	// The token expires in 10 days
+	resp := testutils.ModifyPlan(t, scim_token.NewResource(), c, state, rotation(nil, 30))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %s", resp.Diagnostics)
+	}
+	if len(resp.RequiresReplace) != 1 || !resp.RequiresReplace[0].Equal(path.Root("expires_at")) {
+		t.Fatalf("expected a replacement forced by expires_at, got %v", resp.RequiresReplace)
+	}
+	var tokenValue types.String
+	resp.Plan.GetAttribute(context.Background(), path.Root("token_value"), &tokenValue)
+	if !tokenValue.IsUnknown() {
+		t.Errorf("expected token_value to be unknown in the plan, got %s", tokenValue)
+	}
+
+	resp = testutils.ModifyPlan(t, scim_token.NewResource(), c, state, rotation(nil, 7))
+	if len(resp.RequiresReplace) != 0 {
+		t.Errorf("expected no replacement before the threshold, got %v", resp.RequiresReplace)
+	}
+
+	resp = testutils.ModifyPlan(t, scim_token.NewResource(), c, state, rotation(90, nil))
+	if len(resp.RequiresReplace) != 1 || !resp.RequiresReplace[0].Equal(path.Root("created_at")) {
+		t.Errorf("expected a replacement forced by created_at, got %v", resp.RequiresReplace)
+	}
+
+	// Changing only the rotation settings updates them in place
+	state, diags := testutils.Update(t, scim_token.NewResource(), c, state, rotation(180, nil))
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+	var afterDays types.Int64
+	state.GetAttribute(context.Background(), path.Root("rotation").AtName("rotate_after_days"), &afterDays)
+	if afterDays.ValueInt64() != 180 {
+		t.Errorf("expected rotate_after_days 180, got %s", afterDays)
+	}
+	testutils.CheckStringAttribute(t, state.GetAttribute, "id", "scim-1")
+}
+
+func TestScimTokenResource_expiry(t *testing.T) {
+	cases := map[string]struct {
+		expiresIn      time.Duration
+		warningDays    int
+		errorOnExpired bool
+		attrs          map[string]tftypes.Value
+		wantWarning    string
+		wantError      string
+	}{
+		"outside the window": {
+			expiresIn:   10 * 24 * time.Hour,
+			warningDays: 7,
+		},
+		"inside the window": {
+			expiresIn:   10 * 24 * time.Hour,
+			warningDays: 14,
+			wantWarning: "Token Expiring Soon",
+		},
+		"warning disabled": {
+			expiresIn: 10 * 24 * time.Hour,
+		},
+		"expired": {
+			expiresIn:   -time.Hour,
+			wantWarning: "Token Expired",
+		},
+		"expired with errors on": {
+			expiresIn:      -time.Hour,
+			errorOnExpired: true,
+			wantError:      "Token Expired",
+		},
+		"expired with errors on and being replaced": {
+			expiresIn:      -time.Hour,
+			errorOnExpired: true,
+			attrs:          map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "okta-2")},
+		},
+		"expired with errors on and an in-place change": {
+			expiresIn:      -time.Hour,
+			errorOnExpired: true,
+			attrs: map[string]tftypes.Value{"rotation": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"rotate_after_days":         tftypes.Number,
+				"rotate_before_expiry_days": tftypes.Number,
+			}}, map[string]tftypes.Value{
+				"rotate_after_days":         tftypes.NewValue(tftypes.Number, 100000),
+				"rotate_before_expiry_days": tftypes.NewValue(tftypes.Number, nil),
+			})},
+			wantWarning: "Token Expired",
+		},
+		"expiring soon and being replaced": {
+			expiresIn:   10 * 24 * time.Hour,
+			warningDays: 14,
+			attrs:       map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "okta-2")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server, mux := testutils.NewMockLocalskillsServer()
+			defer server.Close()
+
+			mux.HandleFunc("/api/tenants/tenant-1/scim-tokens", func(w http.ResponseWriter, r *http.Request) {
+				testutils.RespondData(w, []map[string]interface{}{
+					{"id": "scim-1", "name": "okta", "createdAt": "2024-01-01T00:00:00Z", "expiresAt": time.Now().Add(tc.expiresIn).Format(time.RFC3339)},
+				})
+			})
+
+			c := client.NewClient(server.URL, "lsk_test123")
+			state, _ := testutils.ImportByIdentity(t, scim_token.NewResource(), c, map[string]tftypes.Value{
+				"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+				"id":        tftypes.NewValue(tftypes.String, "scim-1"),
+			})
+
+			r := scim_token.NewResource()
+			testutils.ConfigureResource(t, r, c, tokenlifecycle.Policy{ExpiryWarningDays: tc.warningDays, ErrorOnExpired: tc.errorOnExpired})
+			resp := testutils.ModifyPlan(t, r, nil, state, tc.attrs)
+
+			var gotWarning, gotError string
+			for _, d := range resp.Diagnostics.Warnings() {
+				gotWarning = d.Summary()
+			}
+			for _, d := range resp.Diagnostics.Errors() {
+				gotError = d.Summary()
+			}
+			if gotWarning != tc.wantWarning {
+				t.Errorf("expected warning %q, got %q", tc.wantWarning, gotWarning)
+			}
+			if gotError != tc.wantError {
+				t.Errorf("expected error %q, got %q", tc.wantError, gotError)
+			}
+		})
+	}
+}
+
 func testAccScimTokenConfig(tenantID, name string) string {
 	return `
 resource "localskills_scim_token" "test" {
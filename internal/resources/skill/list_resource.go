@@ -0,0 +1,134 @@
+package skill
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+)
+
+var (
+	_ list.ListResource              = &SkillListResource{}
+	_ list.ListResourceWithConfigure = &SkillListResource{}
+)
+
+type SkillListResource struct {
+	client *client.Client
+}
+
+type SkillListModel struct {
+	TenantID   types.String `tfsdk:"tenant_id"`
+	Type       types.String `tfsdk:"type"`
+	Visibility types.String `tfsdk:"visibility"`
+	Tag        types.String `tfsdk:"tag"`
+}
+
+func NewListResource() list.ListResource {
+	return &SkillListResource{}
+}
+
+func (r *SkillListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_skill"
+}
+
+func (r *SkillListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists skills on localskills.sh so they can be discovered with `terraform query` and imported.",
+		Attributes: map[string]schema.Attribute{
+			"tenant_id": schema.StringAttribute{
+				Description: "Only list skills owned by this tenant (team) ID.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only list skills of this type. Must be 'skill' or 'rule'.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("skill", "rule"),
+				},
+			},
+			"visibility": schema.StringAttribute{
+				Description: "Only list skills with this visibility. Must be 'public', 'private', or 'unlisted'.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("public", "private", "unlisted"),
+				},
+			},
+			"tag": schema.StringAttribute{
+				Description: "Only list skills with this tag.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *SkillListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+func (r *SkillListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config SkillListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	params := map[string]string{}
+	if !config.TenantID.IsNull() {
+		params["tenant_id"] = config.TenantID.ValueString()
+	}
+	if !config.Type.IsNull() {
+		params["type"] = config.Type.ValueString()
+	}
+	if !config.Visibility.IsNull() {
+		params["visibility"] = config.Visibility.ValueString()
+	}
+	if !config.Tag.IsNull() {
+		params["tag"] = config.Tag.ValueString()
+	}
+
+	skills, err := r.client.ListSkills(ctx, params)
+	if err != nil {
+		diags.AddError("Error listing skills", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i := range skills {
+			result := req.NewListResult(ctx)
+			result.DisplayName = skills[i].Name
+			result.Diagnostics.Append(result.Identity.Set(ctx, SkillIdentityModel{ID: types.StringValue(skills[i].ID)})...)
+
+			if req.IncludeResource {
+				var state SkillModel
+				mapSkillToState(ctx, &state, &skills[i], &result.Diagnostics)
+				state.Content = types.StringNull()
+				state.AdoptExisting = types.BoolValue(false)
+				state.DeletionProtection = types.BoolValue(false)
+				result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package skill_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/resources/skill"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
+)
+
+func TestSkillListResource_list(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/skills", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("tenant_id"); got != "tenant-1" {
+			t.Errorf("expected tenant_id filter 'tenant-1', got '%s'", got)
+		}
+		if got := r.URL.Query().Get("type"); got != "rule" {
+			t.Errorf("expected type filter 'rule', got '%s'", got)
+		}
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "skill-1", "tenantId": "tenant-1", "name": "Lint Rules", "slug": "lint-rules", "type": "rule", "visibility": "private", "tags": []string{"go"}},
+		})
+	})
+
+	results := testutils.ListAll(t, skill.NewListResource(), skill.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"tenant_id":  tftypes.NewValue(tftypes.String, "tenant-1"),
+		"type":       tftypes.NewValue(tftypes.String, "rule"),
+		"visibility": tftypes.NewValue(tftypes.String, nil),
+		"tag":        tftypes.NewValue(tftypes.String, nil),
+	}, true)
+
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	if results[0].DisplayName != "Lint Rules" {
+		t.Errorf("expected display name 'Lint Rules', got '%s'", results[0].DisplayName)
+	}
+
+	testutils.CheckStringAttribute(t, results[0].Identity.GetAttribute, "id", "skill-1")
+	testutils.CheckStringAttribute(t, results[0].Resource.GetAttribute, "slug", "lint-rules")
+	testutils.CheckStringAttribute(t, results[0].Resource.GetAttribute, "tenant_id", "tenant-1")
+}
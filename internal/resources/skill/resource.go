@@ -3,24 +3,29 @@ package skill
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/providerdata"
 )
 
 var (
 	_ resource.Resource                = &SkillResource{}
 	_ resource.ResourceWithConfigure   = &SkillResource{}
 	_ resource.ResourceWithImportState = &SkillResource{}
+	_ resource.ResourceWithIdentity    = &SkillResource{}
 )
 
 type SkillResource struct {
@@ -126,6 +131,29 @@ func (r *SkillResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "The timestamp when the skill was last updated.",
 				Computed:    true,
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Description: "If true and a skill with the same slug already exists in the tenant, adopt it into Terraform state instead of failing. The existing skill must have the same type. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "If true, Terraform refuses to destroy or replace this skill. Set to false and apply before destroying it. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *SkillResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The internal ID of the skill.",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
@@ -134,15 +162,15 @@ func (r *SkillResource) Configure(_ context.Context, req resource.ConfigureReque
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerdata.ResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ResourceData, got: %T", req.ProviderData),
 		)
 		return
 	}
-	r.client = c
+	r.client = data.Client
 }
 
 func (r *SkillResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -174,14 +202,21 @@ func (r *SkillResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	skill, err := r.client.CreateSkill(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating skill", err.Error())
-		return
+		if !client.IsConflict(err) || !plan.AdoptExisting.ValueBool() {
+			resp.Diagnostics.AddError("Error creating skill", err.Error())
+			return
+		}
+		skill = r.adoptExistingSkill(ctx, &plan, tags, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	mapSkillToState(ctx, &plan, skill, &resp.Diagnostics)
 	plan.Content = types.StringValue(createReq.Content)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, SkillIdentityModel{ID: plan.ID})...)
 }
 
 func (r *SkillResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -204,8 +239,15 @@ func (r *SkillResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	preservedContent := state.Content
 	mapSkillWithVersionToState(ctx, &state, skill, &resp.Diagnostics)
 	state.Content = preservedContent
+	if state.AdoptExisting.IsNull() {
+		state.AdoptExisting = types.BoolValue(false)
+	}
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, SkillIdentityModel{ID: state.ID})...)
 }
 
 func (r *SkillResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -250,6 +292,7 @@ func (r *SkillResource) Update(ctx context.Context, req resource.UpdateRequest,
 	plan.Content = types.StringValue(plan.Content.ValueString())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, SkillIdentityModel{ID: plan.ID})...)
 }
 
 func (r *SkillResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -259,6 +302,14 @@ func (r *SkillResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("Cannot destroy skill %q because deletion_protection is true. Set deletion_protection = false and apply before destroying or replacing it.", state.ID.ValueString()),
+		)
+		return
+	}
+
 	err := r.client.DeleteSkill(ctx, state.ID.ValueString())
 	if err != nil {
 		if client.IsNotFound(err) {
@@ -269,7 +320,143 @@ func (r *SkillResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *SkillResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import blocks using an identity carry the internal ID directly
+	if req.ID == "" {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		return
+	}
+
+	skillID, err := r.resolveImportID(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected a skill ID, public ID, or team_slug/skill_slug: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), skillID)...)
+}
+
+// resolveImportID maps a human-readable import ID to the skill's internal ID.
+// Accepted forms are team_slug/skill_slug, the internal ID, and the public ID.
+func (r *SkillResource) resolveImportID(ctx context.Context, importID string) (string, error) {
+	if teamSlug, skillSlug, ok := strings.Cut(importID, "/"); ok {
+		if teamSlug == "" || skillSlug == "" {
+			return "", fmt.Errorf("got %q", importID)
+		}
+
+		tenants, err := r.client.ListTenants(ctx)
+		if err != nil {
+			return "", err
+		}
+		var tenantID string
+		for _, t := range tenants {
+			if t.Slug == teamSlug {
+				tenantID = t.ID
+				break
+			}
+		}
+		if tenantID == "" {
+			return "", fmt.Errorf("team %q not found", teamSlug)
+		}
+
+		skills, err := r.client.ListSkills(ctx, map[string]string{"tenant_id": tenantID})
+		if err != nil {
+			return "", err
+		}
+		for _, s := range skills {
+			if s.TenantID == tenantID && s.Slug == skillSlug {
+				return s.ID, nil
+			}
+		}
+		return "", fmt.Errorf("skill %q not found in team %q", skillSlug, teamSlug)
+	}
+
+	_, err := r.client.GetSkill(ctx, importID)
+	if err == nil {
+		return importID, nil
+	}
+	if !client.IsNotFound(err) {
+		return "", err
+	}
+
+	skills, err := r.client.ListSkills(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	for _, s := range skills {
+		if s.PublicID == importID {
+			return s.ID, nil
+		}
+	}
+	return "", fmt.Errorf("skill %q not found", importID)
+}
+
+// adoptExistingSkill looks up the skill that caused a create conflict and takes
+// ownership of it, bringing its mutable attributes in line with the plan. The
+// conflict is on the slug, so only a skill with the slug the plan would get is
+// adopted; a skill that merely shares the name is left alone.
+func (r *SkillResource) adoptExistingSkill(ctx context.Context, plan *SkillModel, tags []string, diags *diag.Diagnostics) *client.Skill {
+	tenantID := plan.TenantID.ValueString()
+	name := plan.Name.ValueString()
+	slug := client.Slugify(name)
+
+	skills, err := r.client.ListSkills(ctx, map[string]string{"tenant_id": tenantID})
+	if err != nil {
+		diags.AddError("Error looking up existing skill", err.Error())
+		return nil
+	}
+
+	var existing *client.Skill
+	for i := range skills {
+		if skills[i].TenantID != tenantID {
+			continue
+		}
+		if skills[i].Slug == slug {
+			existing = &skills[i]
+			break
+		}
+	}
+
+	if existing == nil {
+		diags.AddError(
+			"Error creating skill",
+			fmt.Sprintf("The API reported that skill %q already exists, but no skill with slug %q was found in tenant %q.", name, slug, tenantID),
+		)
+		return nil
+	}
+
+	if existing.Type != plan.Type.ValueString() {
+		diags.AddError(
+			"Cannot Adopt Existing Skill",
+			fmt.Sprintf("Skill %q (%s) already exists in tenant %q with type %q, but the configuration specifies type %q.",
+				existing.Slug, existing.ID, tenantID, existing.Type, plan.Type.ValueString()),
+		)
+		return nil
+	}
+
+	description := plan.Description.ValueString()
+	visibility := plan.Visibility.ValueString()
+	updated, err := r.client.UpdateSkill(ctx, existing.ID, client.UpdateSkillRequest{
+		Name:        &name,
+		Description: &description,
+		Visibility:  &visibility,
+		Tags:        tags,
+	})
+	if err != nil {
+		diags.AddError("Error updating adopted skill", err.Error())
+		return nil
+	}
+
+	diags.AddWarning(
+		"Adopted Existing Skill",
+		fmt.Sprintf("Skill %q (%s) already existed in tenant %q and is now managed by Terraform. "+
+			"Its content was not changed; use localskills_skill_version to publish new content.",
+			updated.Slug, updated.ID, tenantID),
+	)
+
+	return updated
 }
 
 func mapSkillToState(ctx context.Context, state *SkillModel, skill *client.Skill, diags *diag.Diagnostics) {
@@ -1,10 +1,19 @@
 package skill_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/resources/skill"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
 )
 
@@ -74,8 +83,293 @@ func TestAccSkillResource_import(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"content"},
 			},
+			{
+				ResourceName:            "localskills_skill.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccSkillImportStateAttrFunc("localskills_skill.test", "public_id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+		},
+	})
+}
+
+func TestAccSkillResource_adoptExisting(t *testing.T) {
+	name := testutils.RandomName("tf-test-skill")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkillResourceConfigAdopt(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("localskills_skill.adopted", "id", "localskills_skill.test", "id"),
+					resource.TestCheckResourceAttr("localskills_skill.adopted", "adopt_existing", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestSkillResource_identityRoundTrip(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/skills/skill-1", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, map[string]interface{}{
+			"id":             "skill-1",
+			"publicId":       "pub-1",
+			"tenantId":       "tenant-1",
+			"name":           "Imported Skill",
+			"slug":           "imported-skill",
+			"type":           "skill",
+			"visibility":     "private",
+			"currentVersion": 1,
+		})
+	})
+
+	state, identity := testutils.ImportByIdentity(t, skill.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "skill-1"),
+	})
+
+	testutils.CheckStringAttribute(t, identity.GetAttribute, "id", "skill-1")
+
+	testutils.CheckStringAttribute(t, state.GetAttribute, "id", "skill-1")
+	testutils.CheckStringAttribute(t, state.GetAttribute, "tenant_id", "tenant-1")
+}
+
+func TestSkillResource_deletionProtection(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	var deletes int
+	mux.HandleFunc("/api/skills/skill-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodDelete {
+			deletes++
+			json.NewEncoder(w).Encode(map[string]interface{}{"success": true, "data": nil})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"data": map[string]interface{}{
+				"id":         "skill-1",
+				"tenantId":   "tenant-1",
+				"name":       "Protected Skill",
+				"slug":       "protected-skill",
+				"type":       "skill",
+				"visibility": "public",
+			},
+		})
+	})
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	state, _ := testutils.ImportByIdentity(t, skill.NewResource(), c, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "skill-1"),
+	})
+
+	if diags := state.SetAttribute(context.Background(), path.Root("deletion_protection"), true); diags.HasError() {
+		t.Fatalf("unexpected errors setting deletion_protection: %s", diags)
+	}
+	diags := testutils.Delete(t, skill.NewResource(), c, state)
+	if !diags.HasError() {
+		t.Fatal("expected an error deleting a protected skill")
+	}
+	if deletes != 0 {
+		t.Fatalf("expected no DELETE request, got %d", deletes)
+	}
+
+	if diags := state.SetAttribute(context.Background(), path.Root("deletion_protection"), false); diags.HasError() {
+		t.Fatalf("unexpected errors setting deletion_protection: %s", diags)
+	}
+	diags = testutils.Delete(t, skill.NewResource(), c, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+	if deletes != 1 {
+		t.Fatalf("expected 1 DELETE request, got %d", deletes)
+	}
+}
+
+func TestSkillResource_adoptExisting(t *testing.T) {
+	cases := map[string]struct {
+		skills    []map[string]interface{}
+		wantID    string
+		wantError bool
+	}{
+		"adopts the skill with the planned slug": {
+			skills: []map[string]interface{}{
+				{"id": "skill-1", "tenantId": "tenant-1", "name": "Deploy Helper", "slug": "deploy-helper-2", "type": "skill"},
+				{"id": "skill-2", "tenantId": "tenant-1", "name": "Old Name", "slug": "deploy-helper", "type": "skill"},
+			},
+			wantID: "skill-2",
 		},
+		"ignores a skill that only shares the name": {
+			skills: []map[string]interface{}{
+				{"id": "skill-1", "tenantId": "tenant-1", "name": "Deploy Helper", "slug": "deploy-helper-2", "type": "skill"},
+			},
+			wantError: true,
+		},
+		"ignores a skill with the slug in another tenant": {
+			skills: []map[string]interface{}{
+				{"id": "skill-3", "tenantId": "tenant-2", "name": "Deploy Helper", "slug": "deploy-helper", "type": "skill"},
+			},
+			wantError: true,
+		},
+		"rejects a skill of another type": {
+			skills: []map[string]interface{}{
+				{"id": "skill-2", "tenantId": "tenant-1", "name": "Deploy Helper", "slug": "deploy-helper", "type": "rule"},
+			},
+			wantError: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server, mux := testutils.NewMockLocalskillsServer()
+			defer server.Close()
+
+			mux.HandleFunc("/api/skills", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					testutils.RespondError(w, http.StatusConflict, "a skill with this slug already exists")
+					return
+				}
+				if got := r.URL.Query().Get("tenant_id"); got != "tenant-1" {
+					t.Errorf("expected skills listed for tenant-1, got %q", got)
+				}
+				testutils.RespondData(w, tc.skills)
+			})
+			var updated []string
+			mux.HandleFunc("/api/skills/", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut {
+					t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+					return
+				}
+				id := strings.TrimPrefix(r.URL.Path, "/api/skills/")
+				updated = append(updated, id)
+				testutils.RespondData(w, map[string]interface{}{
+					"id":         id,
+					"tenantId":   "tenant-1",
+					"name":       "Deploy Helper",
+					"slug":       "deploy-helper",
+					"type":       "skill",
+					"visibility": "private",
+				})
+			})
+
+			state, diags := testutils.Create(t, skill.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+				"tenant_id":           tftypes.NewValue(tftypes.String, "tenant-1"),
+				"name":                tftypes.NewValue(tftypes.String, "Deploy Helper"),
+				"description":         tftypes.NewValue(tftypes.String, ""),
+				"type":                tftypes.NewValue(tftypes.String, "skill"),
+				"visibility":          tftypes.NewValue(tftypes.String, "private"),
+				"content":             tftypes.NewValue(tftypes.String, "# Deploy Helper"),
+				"tags":                tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, true),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, false),
+			})
+
+			if tc.wantError {
+				if !diags.HasError() {
+					t.Fatal("expected an error adopting the skill")
+				}
+				if len(updated) != 0 {
+					t.Fatalf("expected no skill to be updated, got %v", updated)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %s", diags)
+			}
+			if len(diags.Warnings()) != 1 || diags.Warnings()[0].Summary() != "Adopted Existing Skill" {
+				t.Fatalf("expected an adoption warning, got %s", diags)
+			}
+			if len(updated) != 1 || updated[0] != tc.wantID {
+				t.Fatalf("expected %s to be updated, got %v", tc.wantID, updated)
+			}
+			testutils.CheckStringAttribute(t, state.GetAttribute, "id", tc.wantID)
+			testutils.CheckStringAttribute(t, state.GetAttribute, "slug", "deploy-helper")
+		})
+	}
+}
+
+func TestSkillResource_importID(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	skills := []map[string]interface{}{
+		{"id": "skill-1", "publicId": "pub-1", "tenantId": "tenant-1", "name": "Deploy Helper", "slug": "deploy-helper", "type": "skill"},
+		{"id": "skill-2", "publicId": "pub-2", "tenantId": "tenant-2", "name": "Deploy Helper", "slug": "deploy-helper", "type": "skill"},
+	}
+	mux.HandleFunc("/api/tenants", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "tenant-1", "name": "Team One", "slug": "team-one", "role": "owner"},
+			{"id": "tenant-2", "name": "Team Two", "slug": "team-two", "role": "member"},
+		})
+	})
+	mux.HandleFunc("/api/skills", func(w http.ResponseWriter, r *http.Request) {
+		tenantID := r.URL.Query().Get("tenant_id")
+		matched := []map[string]interface{}{}
+		for _, s := range skills {
+			if tenantID == "" || s["tenantId"] == tenantID {
+				matched = append(matched, s)
+			}
+		}
+		testutils.RespondData(w, matched)
 	})
+	mux.HandleFunc("/api/skills/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/api/skills/")
+		for _, s := range skills {
+			if s["id"] == id {
+				testutils.RespondData(w, s)
+				return
+			}
+		}
+		testutils.RespondError(w, http.StatusNotFound, "skill not found")
+	})
+
+	cases := map[string]struct {
+		importID string
+		wantID   string
+	}{
+		"internal ID":                 {importID: "skill-2", wantID: "skill-2"},
+		"public ID":                   {importID: "pub-2", wantID: "skill-2"},
+		"team and skill slug":         {importID: "team-one/deploy-helper", wantID: "skill-1"},
+		"slug in another team":        {importID: "team-two/deploy-helper", wantID: "skill-2"},
+		"unknown ID":                  {importID: "pub-9"},
+		"unknown team":                {importID: "team-nine/deploy-helper"},
+		"unknown skill in a team":     {importID: "team-one/lint-rules"},
+		"team slug without a skill":   {importID: "team-one/"},
+		"skill slug without the team": {importID: "deploy-helper"},
+	}
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			state, diags := testutils.ImportByID(t, skill.NewResource(), c, tc.importID)
+			if tc.wantID == "" {
+				if !diags.HasError() {
+					t.Fatalf("expected an error importing %q", tc.importID)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %s", diags)
+			}
+			testutils.CheckStringAttribute(t, state.GetAttribute, "id", tc.wantID)
+		})
+	}
+}
+
+func testAccSkillImportStateAttrFunc(resourceName, attr string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes[attr], nil
+	}
 }
 
 func testAccSkillResourceConfig(name string) string {
@@ -102,3 +396,18 @@ resource "localskills_skill" "test" {
 }
 `, name)
 }
+
+func testAccSkillResourceConfigAdopt(name string) string {
+	return testAccSkillResourceConfig(name) + fmt.Sprintf(`
+resource "localskills_skill" "adopted" {
+  tenant_id      = "default"
+  name           = %q
+  type           = "skill"
+  visibility     = "private"
+  content        = "# Test Skill\nThis is a test."
+  adopt_existing = true
+
+  depends_on = [localskills_skill.test]
+}
+`, name)
+}
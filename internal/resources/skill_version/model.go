@@ -18,3 +18,8 @@ type SkillVersionModel struct {
 	CreatedBy   types.String `tfsdk:"created_by"`
 	CreatedAt   types.String `tfsdk:"created_at"`
 }
+
+type SkillVersionIdentityModel struct {
+	SkillID types.String `tfsdk:"skill_id"`
+	Version types.Int64  `tfsdk:"version"`
+}
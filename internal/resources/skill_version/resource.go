@@ -9,18 +9,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/providerdata"
 )
 
 var (
 	_ resource.Resource                = &SkillVersionResource{}
 	_ resource.ResourceWithConfigure   = &SkillVersionResource{}
 	_ resource.ResourceWithImportState = &SkillVersionResource{}
+	_ resource.ResourceWithIdentity    = &SkillVersionResource{}
 )
 
 type SkillVersionResource struct {
@@ -113,19 +116,34 @@ func (r *SkillVersionResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+func (r *SkillVersionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"skill_id": identityschema.StringAttribute{
+				Description:       "The ID of the skill this version belongs to.",
+				RequiredForImport: true,
+			},
+			"version": identityschema.Int64Attribute{
+				Description:       "The version number.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *SkillVersionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerdata.ResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ResourceData, got: %T", req.ProviderData),
 		)
 		return
 	}
-	r.client = c
+	r.client = data.Client
 }
 
 func (r *SkillVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -165,6 +183,7 @@ func (r *SkillVersionResource) Create(ctx context.Context, req resource.CreateRe
 	plan.CreatedAt = types.StringValue(ver.CreatedAt)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, SkillVersionIdentityModel{SkillID: plan.SkillID, Version: plan.Version})...)
 }
 
 func (r *SkillVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -174,27 +193,13 @@ func (r *SkillVersionResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	versions, err := r.client.ListSkillVersions(ctx, state.SkillID.ValueString())
+	found, err := r.client.GetSkillVersion(ctx, state.SkillID.ValueString(), int(state.Version.ValueInt64()))
 	if err != nil {
 		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error reading skill versions", err.Error())
-		return
-	}
-
-	versionNum := state.Version.ValueInt64()
-	var found *client.SkillVersion
-	for i := range versions {
-		if int64(versions[i].Version) == versionNum {
-			found = &versions[i]
-			break
-		}
-	}
-
-	if found == nil {
-		resp.State.RemoveResource(ctx)
+		resp.Diagnostics.AddError("Error reading skill version", err.Error())
 		return
 	}
 
@@ -216,6 +221,7 @@ func (r *SkillVersionResource) Read(ctx context.Context, req resource.ReadReques
 	state.Bump = preservedBump
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, SkillVersionIdentityModel{SkillID: state.SkillID, Version: state.Version})...)
 }
 
 func (r *SkillVersionResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -252,6 +258,22 @@ func (r *SkillVersionResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *SkillVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity SkillVersionIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("skill_id"), identity.SkillID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), identity.Version)...)
+		return
+	}
+
+	if skillRef, semver, ok := strings.Cut(req.ID, "@"); ok {
+		r.importBySemver(ctx, skillRef, semver, resp)
+		return
+	}
+
 	parts := strings.SplitN(req.ID, "/", 2)
 	if len(parts) != 2 {
 		resp.Diagnostics.AddError(
@@ -274,3 +296,80 @@ func (r *SkillVersionResource) ImportState(ctx context.Context, req resource.Imp
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("skill_id"), types.StringValue(skillID))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), types.Int64Value(versionNum))...)
 }
+
+// importBySemver resolves an import ID of the form skill_slug@semver or
+// team_slug/skill_slug@semver to a skill ID and version number.
+func (r *SkillVersionResource) importBySemver(ctx context.Context, skillRef, semver string, resp *resource.ImportStateResponse) {
+	semver = strings.TrimPrefix(semver, "v")
+	if skillRef == "" || semver == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected format: skill_slug@semver or team_slug/skill_slug@semver, got: %s@%s", skillRef, semver),
+		)
+		return
+	}
+
+	params := map[string]string{}
+	skillSlug := skillRef
+	if teamSlug, slug, ok := strings.Cut(skillRef, "/"); ok {
+		tenants, err := r.client.ListTenants(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading teams for import", err.Error())
+			return
+		}
+		for _, t := range tenants {
+			if t.Slug == teamSlug {
+				params["tenant_id"] = t.ID
+				break
+			}
+		}
+		if params["tenant_id"] == "" {
+			resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Team %q not found.", teamSlug))
+			return
+		}
+		skillSlug = slug
+	}
+
+	skills, err := r.client.ListSkills(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading skills for import", err.Error())
+		return
+	}
+
+	var matches []client.Skill
+	for _, s := range skills {
+		if s.Slug == skillSlug || s.ID == skillSlug {
+			matches = append(matches, s)
+		}
+	}
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Skill %q not found.", skillRef))
+		return
+	}
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Ambiguous Import ID",
+			fmt.Sprintf("Skill slug %q exists in more than one team. Use team_slug/skill_slug@semver instead.", skillSlug),
+		)
+		return
+	}
+
+	versions, err := r.client.ListSkillVersions(ctx, matches[0].ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading skill versions for import", err.Error())
+		return
+	}
+
+	for _, v := range versions {
+		if v.Semver == semver {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("skill_id"), types.StringValue(matches[0].ID))...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), types.Int64Value(int64(v.Version)))...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Invalid Import ID",
+		fmt.Sprintf("Skill %q has no version %q.", skillRef, semver),
+	)
+}
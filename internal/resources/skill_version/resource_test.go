@@ -1,10 +1,19 @@
 package skill_version_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/resources/skill_version"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
 )
 
@@ -27,10 +36,136 @@ func TestAccSkillVersionResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("localskills_skill_version.test", "created_at"),
 				),
 			},
+			{
+				ResourceName:            "localskills_skill_version.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccSkillVersionImportStateSemverFunc("localskills_skill.test", "localskills_skill_version.test"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "message", "bump"},
+			},
 		},
 	})
 }
 
+func TestSkillVersionResource_identityRoundTrip(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/skills/skill-1/versions", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "ver-2", "skillId": "skill-1", "version": 2, "semver": "1.1.0"},
+		})
+	})
+
+	state, identity := testutils.ImportByIdentity(t, skill_version.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"skill_id": tftypes.NewValue(tftypes.String, "skill-1"),
+		"version":  tftypes.NewValue(tftypes.Number, 2),
+	})
+
+	testutils.CheckStringAttribute(t, identity.GetAttribute, "skill_id", "skill-1")
+
+	var version types.Int64
+	if diags := identity.GetAttribute(context.Background(), path.Root("version"), &version); diags.HasError() {
+		t.Fatalf("unexpected errors reading identity: %s", diags)
+	}
+	if version.ValueInt64() != 2 {
+		t.Errorf("expected identity version 2, got %d", version.ValueInt64())
+	}
+
+	testutils.CheckStringAttribute(t, state.GetAttribute, "id", "ver-2")
+	testutils.CheckStringAttribute(t, state.GetAttribute, "semver", "1.1.0")
+}
+
+func TestSkillVersionResource_importSemver(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	skills := []map[string]interface{}{
+		{"id": "skill-1", "tenantId": "tenant-1", "name": "Deploy Helper", "slug": "deploy-helper", "type": "skill"},
+		{"id": "skill-2", "tenantId": "tenant-1", "name": "Lint Rules", "slug": "lint-rules", "type": "rule"},
+		{"id": "skill-3", "tenantId": "tenant-2", "name": "Lint Rules", "slug": "lint-rules", "type": "rule"},
+	}
+	mux.HandleFunc("/api/tenants", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "tenant-1", "name": "Team One", "slug": "team-one", "role": "owner"},
+			{"id": "tenant-2", "name": "Team Two", "slug": "team-two", "role": "member"},
+		})
+	})
+	mux.HandleFunc("/api/skills", func(w http.ResponseWriter, r *http.Request) {
+		tenantID := r.URL.Query().Get("tenant_id")
+		matched := []map[string]interface{}{}
+		for _, s := range skills {
+			if tenantID == "" || s["tenantId"] == tenantID {
+				matched = append(matched, s)
+			}
+		}
+		testutils.RespondData(w, matched)
+	})
+	mux.HandleFunc("/api/skills/", func(w http.ResponseWriter, r *http.Request) {
+		skillID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/skills/"), "/versions")
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": skillID + "-ver-1", "skillId": skillID, "version": 1, "semver": "1.3.0"},
+			{"id": skillID + "-ver-2", "skillId": skillID, "version": 2, "semver": "1.4.0"},
+		})
+	})
+
+	cases := map[string]struct {
+		importID    string
+		wantSkillID string
+		wantVersion int64
+		wantError   string
+	}{
+		"skill slug":                  {importID: "deploy-helper@1.4.0", wantSkillID: "skill-1", wantVersion: 2},
+		"v-prefixed semver":           {importID: "deploy-helper@v1.3.0", wantSkillID: "skill-1", wantVersion: 1},
+		"team and skill slug":         {importID: "team-two/lint-rules@1.4.0", wantSkillID: "skill-3", wantVersion: 2},
+		"skill slug in two teams":     {importID: "lint-rules@1.4.0", wantError: "Ambiguous Import ID"},
+		"unknown skill":               {importID: "format-rules@1.4.0", wantError: "Invalid Import ID"},
+		"unknown team":                {importID: "team-nine/lint-rules@1.4.0", wantError: "Invalid Import ID"},
+		"unknown version":             {importID: "deploy-helper@2.0.0", wantError: "Invalid Import ID"},
+		"missing semver":              {importID: "deploy-helper@", wantError: "Invalid Import ID"},
+		"skill ID and version number": {importID: "skill-1/2", wantSkillID: "skill-1", wantVersion: 2},
+	}
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			state, diags := testutils.ImportByID(t, skill_version.NewResource(), c, tc.importID)
+			if tc.wantError != "" {
+				if !diags.HasError() || diags.Errors()[0].Summary() != tc.wantError {
+					t.Fatalf("expected the error %q importing %q, got %s", tc.wantError, tc.importID, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %s", diags)
+			}
+
+			testutils.CheckStringAttribute(t, state.GetAttribute, "skill_id", tc.wantSkillID)
+			var version types.Int64
+			if diags := state.GetAttribute(context.Background(), path.Root("version"), &version); diags.HasError() {
+				t.Fatalf("unexpected errors reading version: %s", diags)
+			}
+			if version.ValueInt64() != tc.wantVersion {
+				t.Errorf("expected version %d, got %d", tc.wantVersion, version.ValueInt64())
+			}
+		})
+	}
+}
+
+func testAccSkillVersionImportStateSemverFunc(skillName, versionName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		skill, ok := s.RootModule().Resources[skillName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", skillName)
+		}
+		version, ok := s.RootModule().Resources[versionName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", versionName)
+		}
+		return skill.Primary.Attributes["slug"] + "@" + version.Primary.Attributes["semver"], nil
+	}
+}
+
 func testAccSkillVersionResourceConfig(skillName string) string {
 	return fmt.Sprintf(`
 resource "localskills_skill" "test" {
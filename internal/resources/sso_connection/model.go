@@ -5,20 +5,26 @@ import (
 )
 
 type SsoConnectionModel struct {
-	ID           types.String `tfsdk:"id"`
-	TenantID     types.String `tfsdk:"tenant_id"`
-	DisplayName  types.String `tfsdk:"display_name"`
-	MetadataURL  types.String `tfsdk:"metadata_url"`
-	MetadataXML  types.String `tfsdk:"metadata_xml"`
-	DefaultRole  types.String `tfsdk:"default_role"`
-	EmailDomains types.List   `tfsdk:"email_domains"`
-	Enabled      types.Bool   `tfsdk:"enabled"`
-	RequireSso   types.Bool   `tfsdk:"require_sso"`
-	IdpEntityID  types.String `tfsdk:"idp_entity_id"`
-	IdpSsoURL    types.String `tfsdk:"idp_sso_url"`
-	IdpSloURL    types.String `tfsdk:"idp_slo_url"`
-	SpEntityID   types.String `tfsdk:"sp_entity_id"`
-	SpAcsURL     types.String `tfsdk:"sp_acs_url"`
-	CreatedAt    types.String `tfsdk:"created_at"`
-	UpdatedAt    types.String `tfsdk:"updated_at"`
+	ID                 types.String `tfsdk:"id"`
+	TenantID           types.String `tfsdk:"tenant_id"`
+	DisplayName        types.String `tfsdk:"display_name"`
+	MetadataURL        types.String `tfsdk:"metadata_url"`
+	MetadataXML        types.String `tfsdk:"metadata_xml"`
+	DefaultRole        types.String `tfsdk:"default_role"`
+	EmailDomains       types.List   `tfsdk:"email_domains"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	RequireSso         types.Bool   `tfsdk:"require_sso"`
+	IdpEntityID        types.String `tfsdk:"idp_entity_id"`
+	IdpSsoURL          types.String `tfsdk:"idp_sso_url"`
+	IdpSloURL          types.String `tfsdk:"idp_slo_url"`
+	SpEntityID         types.String `tfsdk:"sp_entity_id"`
+	SpAcsURL           types.String `tfsdk:"sp_acs_url"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	OnDestroy          types.String `tfsdk:"on_destroy"`
+}
+
+type SsoConnectionIdentityModel struct {
+	TenantID types.String `tfsdk:"tenant_id"`
 }
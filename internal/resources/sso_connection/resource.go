@@ -6,13 +6,16 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/providerdata"
 
 	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 )
@@ -20,6 +23,7 @@ import (
 var (
 	_ resource.Resource                = &SsoConnectionResource{}
 	_ resource.ResourceWithImportState = &SsoConnectionResource{}
+	_ resource.ResourceWithIdentity    = &SsoConnectionResource{}
 )
 
 type SsoConnectionResource struct {
@@ -127,6 +131,32 @@ func (r *SsoConnectionResource) Schema(_ context.Context, _ resource.SchemaReque
 				Description: "The timestamp when the SSO connection was last updated.",
 				Computed:    true,
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "If true, Terraform refuses to destroy or replace this SSO connection. Set to false and apply before destroying it. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"on_destroy": schema.StringAttribute{
+				Description: "What to do when the SSO connection is destroyed. 'disable' turns off SSO and require_sso on localskills.sh; 'abandon' removes it from Terraform state and leaves it configured; 'error' fails the destroy. Defaults to 'disable'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("disable"),
+				Validators: []validator.String{
+					frameworkvalidator.OneOf("disable", "abandon", "error"),
+				},
+			},
+		},
+	}
+}
+
+func (r *SsoConnectionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"tenant_id": identityschema.StringAttribute{
+				Description:       "The ID of the team (tenant) this SSO connection belongs to.",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
@@ -135,15 +165,15 @@ func (r *SsoConnectionResource) Configure(_ context.Context, req resource.Config
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerdata.ResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ResourceData, got: %T", req.ProviderData),
 		)
 		return
 	}
-	r.client = c
+	r.client = data.Client
 }
 
 func (r *SsoConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -167,6 +197,7 @@ func (r *SsoConnectionResource) Create(ctx context.Context, req resource.CreateR
 	plan.MetadataXML = preservedMetadataXML
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, SsoConnectionIdentityModel{TenantID: plan.TenantID})...)
 }
 
 func (r *SsoConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -191,8 +222,15 @@ func (r *SsoConnectionResource) Read(ctx context.Context, req resource.ReadReque
 
 	mapConnectionToState(conn, &state)
 	state.MetadataXML = preservedMetadataXML
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+	if state.OnDestroy.IsNull() {
+		state.OnDestroy = types.StringValue("disable")
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, SsoConnectionIdentityModel{TenantID: state.TenantID})...)
 }
 
 func (r *SsoConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -216,6 +254,7 @@ func (r *SsoConnectionResource) Update(ctx context.Context, req resource.UpdateR
 	plan.MetadataXML = preservedMetadataXML
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, SsoConnectionIdentityModel{TenantID: plan.TenantID})...)
 }
 
 func (r *SsoConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -225,6 +264,29 @@ func (r *SsoConnectionResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("Cannot destroy the SSO connection of tenant %q because deletion_protection is true. Set deletion_protection = false and apply before destroying or replacing it.", state.TenantID.ValueString()),
+		)
+		return
+	}
+
+	switch state.OnDestroy.ValueString() {
+	case "error":
+		resp.Diagnostics.AddError(
+			"SSO Connection Not Destroyed",
+			fmt.Sprintf("The SSO connection of tenant %q has on_destroy = \"error\". Set on_destroy to \"disable\" or \"abandon\" and apply before destroying it.", state.TenantID.ValueString()),
+		)
+		return
+	case "abandon":
+		resp.Diagnostics.AddWarning(
+			"SSO Connection Abandoned",
+			fmt.Sprintf("The SSO connection of tenant %q was removed from Terraform state but is still configured on localskills.sh.", state.TenantID.ValueString()),
+		)
+		return
+	}
+
 	// SSO connections are singletons — "delete" disables them
 	enabled := false
 	requireSso := false
@@ -237,12 +299,18 @@ func (r *SsoConnectionResource) Delete(ctx context.Context, req resource.DeleteR
 			return
 		}
 		resp.Diagnostics.AddError("Error disabling SSO connection", err.Error())
+		return
 	}
+
+	resp.Diagnostics.AddWarning(
+		"SSO Connection Disabled",
+		fmt.Sprintf("The SSO connection of tenant %q was disabled rather than deleted; its configuration remains on localskills.sh.", state.TenantID.ValueString()),
+	)
 }
 
 func (r *SsoConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Import by tenant_id only — SSO is a singleton per tenant
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tenant_id"), req.ID)...)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("tenant_id"), path.Root("tenant_id"), req, resp)
 }
 
 func buildUpdateRequest(ctx context.Context, plan *SsoConnectionModel) client.UpdateSsoRequest {
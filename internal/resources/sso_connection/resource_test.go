@@ -1,11 +1,17 @@
 package sso_connection_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/resources/sso_connection"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
 )
 
@@ -77,6 +83,65 @@ func TestAccSsoConnection_update(t *testing.T) {
 	})
 }
 
+func TestSsoConnectionResource_identityRoundTrip(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/tenants/tenant-1/sso", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, map[string]interface{}{
+			"id":          "sso-1",
+			"tenantId":    "tenant-1",
+			"displayName": "Okta",
+			"defaultRole": "member",
+			"enabled":     true,
+		})
+	})
+
+	state, identity := testutils.ImportByIdentity(t, sso_connection.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+	})
+
+	testutils.CheckStringAttribute(t, identity.GetAttribute, "tenant_id", "tenant-1")
+
+	testutils.CheckStringAttribute(t, state.GetAttribute, "id", "sso-1")
+	testutils.CheckStringAttribute(t, state.GetAttribute, "display_name", "Okta")
+}
+
+func TestSsoConnectionResource_deletionProtection(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	var updates int
+	mux.HandleFunc("/api/tenants/tenant-1/sso", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			updates++
+		}
+		testutils.RespondData(w, map[string]interface{}{
+			"id":          "sso-1",
+			"tenantId":    "tenant-1",
+			"displayName": "Okta",
+			"defaultRole": "member",
+			"enabled":     true,
+		})
+	})
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	state, _ := testutils.ImportByIdentity(t, sso_connection.NewResource(), c, map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+	})
+
+	if diags := state.SetAttribute(context.Background(), path.Root("deletion_protection"), true); diags.HasError() {
+		t.Fatalf("unexpected errors setting deletion_protection: %s", diags)
+	}
+	diags := testutils.Delete(t, sso_connection.NewResource(), c, state)
+	if !diags.HasError() {
+		t.Fatal("expected an error destroying a protected SSO connection")
+	}
+	if updates != 0 {
+		t.Fatalf("expected the SSO connection to be left untouched, got %d update requests", updates)
+	}
+}
+
 func testAccSsoConnectionConfig(tenantID, name string) string {
 	return fmt.Sprintf(`
 resource "localskills_sso_connection" "test" {
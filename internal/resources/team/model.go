@@ -2,13 +2,24 @@ package team
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/teamsettings"
 )
 
 type TeamModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Slug        types.String `tfsdk:"slug"`
-	Description types.String `tfsdk:"description"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Slug               types.String `tfsdk:"slug"`
+	Description        types.String `tfsdk:"description"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+	AdoptExisting      types.Bool   `tfsdk:"adopt_existing"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	OnDestroy          types.String `tfsdk:"on_destroy"`
+	ForceDestroy       types.Bool   `tfsdk:"force_destroy"`
+
+	teamsettings.Model
+}
+
+type TeamIdentityModel struct {
+	ID types.String `tfsdk:"id"`
 }
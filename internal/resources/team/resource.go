@@ -3,23 +3,41 @@ package team
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/providerdata"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/teamsettings"
 )
 
 var (
-	_ resource.Resource                = &TeamResource{}
-	_ resource.ResourceWithConfigure   = &TeamResource{}
-	_ resource.ResourceWithImportState = &TeamResource{}
+	_ resource.Resource                   = &TeamResource{}
+	_ resource.ResourceWithConfigure      = &TeamResource{}
+	_ resource.ResourceWithImportState    = &TeamResource{}
+	_ resource.ResourceWithIdentity       = &TeamResource{}
+	_ resource.ResourceWithValidateConfig = &TeamResource{}
 )
 
+var visibilities = []string{"public", "private", "unlisted"}
+
 type TeamResource struct {
 	client *client.Client
 }
@@ -34,7 +52,7 @@ func (r *TeamResource) Metadata(_ context.Context, req resource.MetadataRequest,
 
 func (r *TeamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a team (tenant) on localskills.sh.\n\n~> **Note:** Deleting this resource only removes it from Terraform state. The team is **not** deleted from localskills.sh as the API does not support team deletion.",
+		MarkdownDescription: "Manages a team (tenant) on localskills.sh.\n\n~> **Note:** Deleting a team that still owns skills, team tokens or OIDC trust policies fails unless `force_destroy` is set, in which case they are deleted too.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The unique identifier of the team.",
@@ -68,25 +86,150 @@ func (r *TeamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "The timestamp when the team was last updated.",
 				Computed:    true,
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Description: "If true and a team with the slug generated from name already exists, adopt it into Terraform state instead of failing. The authenticated user must be an owner or admin of the existing team. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "If true, Terraform refuses to destroy or replace this team. Set to false and apply before destroying it. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"on_destroy": schema.StringAttribute{
+				Description: "What to do when the team is destroyed. 'delete' deletes it from localskills.sh; 'abandon' removes it from Terraform state and leaves it on localskills.sh; 'error' fails the destroy. Defaults to 'delete'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("delete"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("delete", "abandon", "error"),
+				},
+			},
+			"force_destroy": schema.BoolAttribute{
+				Description: "If true, deleting the team first deletes every skill, team token and OIDC trust policy it owns. If false, deleting a team that still owns any of these fails. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"default_visibility": schema.StringAttribute{
+				Description: "The visibility given to new skills in the team when none is specified. Must be 'public', 'private', or 'unlisted'. Defaults to the server's setting.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(visibilities...),
+				},
+			},
+			"allowed_visibilities": schema.SetAttribute{
+				Description: "The visibilities members may give skills in the team. Each must be 'public', 'private', or 'unlisted'. Defaults to the server's setting.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(visibilities...)),
+				},
+			},
+			"allow_public_skills": schema.BoolAttribute{
+				Description: "Whether the team may own public skills at all. Defaults to the server's setting.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allowed_email_domains": schema.SetAttribute{
+				Description: "The email domains that may be invited to the team, such as example.com. An empty set allows any domain. Defaults to the server's setting.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)+$`),
+						"must be a lowercase domain name such as example.com",
+					)),
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the team.",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
 
+func (r *TeamResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config TeamModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var allowed []string
+	if !config.AllowedVisibilities.IsNull() && !config.AllowedVisibilities.IsUnknown() {
+		resp.Diagnostics.Append(config.AllowedVisibilities.ElementsAs(ctx, &allowed, false)...)
+	}
+	defaultKnown := !config.DefaultVisibility.IsNull() && !config.DefaultVisibility.IsUnknown()
+
+	if defaultKnown && allowed != nil && !slices.Contains(allowed, config.DefaultVisibility.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_visibility"),
+			"Invalid Team Settings",
+			fmt.Sprintf("default_visibility %q must be one of allowed_visibilities.", config.DefaultVisibility.ValueString()),
+		)
+	}
+
+	if config.AllowPublicSkills.IsNull() || config.AllowPublicSkills.IsUnknown() || config.AllowPublicSkills.ValueBool() {
+		return
+	}
+	if defaultKnown && config.DefaultVisibility.ValueString() == "public" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_visibility"),
+			"Invalid Team Settings",
+			"default_visibility cannot be \"public\" when allow_public_skills is false.",
+		)
+	}
+	if slices.Contains(allowed, "public") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("allowed_visibilities"),
+			"Invalid Team Settings",
+			"allowed_visibilities cannot include \"public\" when allow_public_skills is false.",
+		)
+	}
+}
+
 func (r *TeamResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerdata.ResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ResourceData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.Client
 }
 
 func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -100,18 +243,34 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 		Name: plan.Name.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating team", err.Error())
-		return
+		if !client.IsConflict(err) || !plan.AdoptExisting.ValueBool() {
+			resp.Diagnostics.AddError("Error creating team", err.Error())
+			return
+		}
+		tenant = r.adoptExistingTeam(ctx, &plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	// If slug or description were specified, update them
-	if !plan.Slug.IsNull() || !plan.Description.IsNull() {
-		updateReq := client.UpdateTenantRequest{}
-		if !plan.Slug.IsNull() {
+	// If slug, description or settings were specified, update them
+	updateReq := client.UpdateTenantRequest{}
+	settingsChanged := setSettings(ctx, &plan, nil, &updateReq, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	slugSet := !plan.Slug.IsNull() && !plan.Slug.IsUnknown()
+	descriptionSet := !plan.Description.IsNull() && !plan.Description.IsUnknown()
+	if slugSet || descriptionSet || tenant.Name != plan.Name.ValueString() || settingsChanged {
+		if tenant.Name != plan.Name.ValueString() {
+			name := plan.Name.ValueString()
+			updateReq.Name = &name
+		}
+		if slugSet {
 			slug := plan.Slug.ValueString()
 			updateReq.Slug = &slug
 		}
-		if !plan.Description.IsNull() {
+		if descriptionSet {
 			desc := plan.Description.ValueString()
 			updateReq.Description = &desc
 		}
@@ -130,8 +289,10 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	plan.Description = types.StringValue(tenant.Description)
 	plan.CreatedAt = types.StringValue(tenant.CreatedAt)
 	plan.UpdatedAt = types.StringValue(tenant.UpdatedAt)
+	plan.Model = teamsettings.Map(ctx, &tenant.TenantSettings, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamIdentityModel{ID: plan.ID})...)
 }
 
 func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -141,34 +302,41 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	tenants, err := r.client.ListTenants(ctx)
+	tenant, err := r.client.GetTenant(ctx, state.ID.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Team not found, removing from state", map[string]interface{}{
+				"id": state.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Error reading team", err.Error())
 		return
 	}
 
-	var found bool
-	for _, t := range tenants {
-		if t.ID == state.ID.ValueString() {
-			state.Name = types.StringValue(t.Name)
-			state.Slug = types.StringValue(t.Slug)
-			state.Description = types.StringValue(t.Description)
-			state.CreatedAt = types.StringValue(t.CreatedAt)
-			state.UpdatedAt = types.StringValue(t.UpdatedAt)
-			found = true
-			break
-		}
-	}
+	state.Name = types.StringValue(tenant.Name)
+	state.Slug = types.StringValue(tenant.Slug)
+	state.Description = types.StringValue(tenant.Description)
+	state.CreatedAt = types.StringValue(tenant.CreatedAt)
+	state.UpdatedAt = types.StringValue(tenant.UpdatedAt)
+	state.Model = teamsettings.Map(ctx, &tenant.TenantSettings, &resp.Diagnostics)
 
-	if !found {
-		tflog.Warn(ctx, "Team not found, removing from state", map[string]interface{}{
-			"id": state.ID.ValueString(),
-		})
-		resp.State.RemoveResource(ctx)
-		return
+	if state.AdoptExisting.IsNull() {
+		state.AdoptExisting = types.BoolValue(false)
+	}
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+	if state.OnDestroy.IsNull() {
+		state.OnDestroy = types.StringValue("delete")
+	}
+	if state.ForceDestroy.IsNull() {
+		state.ForceDestroy = types.BoolValue(false)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamIdentityModel{ID: state.ID})...)
 }
 
 func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -198,6 +366,10 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		desc := plan.Description.ValueString()
 		updateReq.Description = &desc
 	}
+	setSettings(ctx, &plan, &state, &updateReq, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tenant, err := r.client.UpdateTenant(ctx, state.ID.ValueString(), updateReq)
 	if err != nil {
@@ -211,8 +383,10 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	plan.Description = types.StringValue(tenant.Description)
 	plan.CreatedAt = types.StringValue(tenant.CreatedAt)
 	plan.UpdatedAt = types.StringValue(tenant.UpdatedAt)
+	plan.Model = teamsettings.Map(ctx, &tenant.TenantSettings, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamIdentityModel{ID: plan.ID})...)
 }
 
 func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -222,12 +396,231 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	tflog.Warn(ctx, "Team deletion is not supported by the API. Removing team from Terraform state only.", map[string]interface{}{
-		"id":   state.ID.ValueString(),
-		"name": state.Name.ValueString(),
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("Cannot destroy team %q because deletion_protection is true. Set deletion_protection = false and apply before destroying or replacing it.", state.ID.ValueString()),
+		)
+		return
+	}
+
+	switch state.OnDestroy.ValueString() {
+	case "error":
+		resp.Diagnostics.AddError(
+			"Team Not Destroyed",
+			fmt.Sprintf("Team %q has on_destroy = \"error\". Set on_destroy to \"delete\" or \"abandon\" and apply before destroying it.", state.Name.ValueString()),
+		)
+		return
+	case "abandon":
+		resp.Diagnostics.AddWarning(
+			"Team Abandoned",
+			fmt.Sprintf("Team %q (%s) was removed from Terraform state but still exists on localskills.sh.", state.Name.ValueString(), state.ID.ValueString()),
+		)
+		return
+	}
+
+	r.emptyTeam(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteTenant(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting team", err.Error())
+	}
+}
+
+// emptyTeam makes sure the team owns no skills, team tokens or OIDC trust
+// policies before it is deleted. With force_destroy they are deleted first;
+// otherwise their presence is reported as an error.
+func (r *TeamResource) emptyTeam(ctx context.Context, state *TeamModel, diags *diag.Diagnostics) {
+	tenantID := state.ID.ValueString()
+
+	skills, err := r.client.ListSkills(ctx, map[string]string{"tenant_id": tenantID})
+	if err != nil {
+		diags.AddError("Error listing team skills", err.Error())
+		return
+	}
+	var owned []client.Skill
+	for _, s := range skills {
+		if s.TenantID == tenantID {
+			owned = append(owned, s)
+		}
+	}
+
+	tokens, err := r.client.ListTeamTokens(ctx, tenantID)
+	if err != nil {
+		diags.AddError("Error listing team tokens", err.Error())
+		return
+	}
+
+	policies, err := r.client.ListOIDCPolicies(ctx, tenantID)
+	if err != nil {
+		diags.AddError("Error listing OIDC trust policies", err.Error())
+		return
+	}
+
+	if len(owned) == 0 && len(tokens) == 0 && len(policies) == 0 {
+		return
+	}
+
+	if !state.ForceDestroy.ValueBool() {
+		diags.AddError(
+			"Team Not Empty",
+			fmt.Sprintf("Team %q still owns %d skill(s), %d team token(s) and %d OIDC trust policy(ies). Remove them first, or set force_destroy = true and apply to delete them along with the team.", state.Name.ValueString(), len(owned), len(tokens), len(policies)),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Force destroying team contents", map[string]interface{}{
+		"id":       tenantID,
+		"skills":   len(owned),
+		"tokens":   len(tokens),
+		"policies": len(policies),
 	})
+
+	for _, p := range policies {
+		if err := r.client.DeleteOIDCPolicy(ctx, tenantID, p.ID); err != nil && !client.IsNotFound(err) {
+			diags.AddError("Error deleting OIDC trust policy", err.Error())
+			return
+		}
+	}
+	for _, t := range tokens {
+		if err := r.client.DeleteTeamToken(ctx, tenantID, t.ID); err != nil && !client.IsNotFound(err) {
+			diags.AddError("Error deleting team token", err.Error())
+			return
+		}
+	}
+	for _, s := range owned {
+		if err := r.client.DeleteSkill(ctx, s.ID); err != nil && !client.IsNotFound(err) {
+			diags.AddError("Error deleting skill", err.Error())
+			return
+		}
+	}
 }
 
 func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import blocks using an identity carry the team ID directly
+	if req.ID == "" {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		return
+	}
+
+	tenants, err := r.client.ListTenants(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading teams for import", err.Error())
+		return
+	}
+
+	// Accept either the team ID or its slug
+	for _, t := range tenants {
+		if t.ID == req.ID || t.Slug == req.ID {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), t.ID)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Invalid Import ID",
+		fmt.Sprintf("No team with ID or slug %q was found.", req.ID),
+	)
+}
+
+// adoptExistingTeam looks up the team that caused a create conflict and
+// verifies the caller is allowed to manage it before taking ownership. The
+// conflict is on the slug generated from the name, since a configured slug is
+// only applied after creation, so only a team with that slug is adopted.
+func (r *TeamResource) adoptExistingTeam(ctx context.Context, plan *TeamModel, diags *diag.Diagnostics) *client.Tenant {
+	name := plan.Name.ValueString()
+	slug := client.Slugify(name)
+
+	tenants, err := r.client.ListTenants(ctx)
+	if err != nil {
+		diags.AddError("Error looking up existing team", err.Error())
+		return nil
+	}
+
+	var existing *client.TenantWithRole
+	for i := range tenants {
+		if tenants[i].Slug == slug {
+			existing = &tenants[i]
+			break
+		}
+	}
+
+	if existing == nil {
+		diags.AddError(
+			"Error creating team",
+			fmt.Sprintf("The API reported that team %q already exists, but no team with slug %q is among the teams visible to the authenticated user.", name, slug),
+		)
+		return nil
+	}
+
+	if existing.Role != "owner" && existing.Role != "admin" {
+		diags.AddError(
+			"Cannot Adopt Existing Team",
+			fmt.Sprintf("Team %q (%s) already exists, but the authenticated user has role %q. Adopting a team requires the owner or admin role.",
+				existing.Slug, existing.ID, existing.Role),
+		)
+		return nil
+	}
+
+	diags.AddWarning(
+		"Adopted Existing Team",
+		fmt.Sprintf("Team %q (%s) already existed and is now managed by Terraform.", existing.Slug, existing.ID),
+	)
+
+	return &client.Tenant{
+		ID:             existing.ID,
+		Name:           existing.Name,
+		Slug:           existing.Slug,
+		Description:    existing.Description,
+		CreatedAt:      existing.CreatedAt,
+		UpdatedAt:      existing.UpdatedAt,
+		TenantSettings: existing.TenantSettings,
+	}
+}
+
+// setSettings adds the team settings that are known in plan and differ from
+// state (nil on create) to req, and reports whether it added any.
+func setSettings(ctx context.Context, plan, state *TeamModel, req *client.UpdateTenantRequest, diags *diag.Diagnostics) bool {
+	changed := func(planValue, stateValue attr.Value) bool {
+		if planValue.IsNull() || planValue.IsUnknown() {
+			return false
+		}
+		return state == nil || !planValue.Equal(stateValue)
+	}
+
+	var stateModel TeamModel
+	if state != nil {
+		stateModel = *state
+	}
+
+	var set bool
+	if changed(plan.DefaultVisibility, stateModel.DefaultVisibility) {
+		v := plan.DefaultVisibility.ValueString()
+		req.DefaultVisibility = &v
+		set = true
+	}
+	if changed(plan.AllowedVisibilities, stateModel.AllowedVisibilities) {
+		v := []string{}
+		diags.Append(plan.AllowedVisibilities.ElementsAs(ctx, &v, false)...)
+		req.AllowedVisibilities = &v
+		set = true
+	}
+	if changed(plan.AllowPublicSkills, stateModel.AllowPublicSkills) {
+		v := plan.AllowPublicSkills.ValueBool()
+		req.AllowPublicSkills = &v
+		set = true
+	}
+	if changed(plan.AllowedEmailDomains, stateModel.AllowedEmailDomains) {
+		v := []string{}
+		diags.Append(plan.AllowedEmailDomains.ElementsAs(ctx, &v, false)...)
+		req.AllowedEmailDomains = &v
+		set = true
+	}
+	return set
 }
@@ -1,9 +1,21 @@
 package team_test
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/resources/team"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
 )
 
@@ -29,6 +41,12 @@ func TestAccTeamResource_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "localskills_team.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccTeamImportStateSlugFunc("localskills_team.test"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -58,6 +76,402 @@ func TestAccTeamResource_update(t *testing.T) {
 	})
 }
 
+func TestAccTeamResource_settings(t *testing.T) {
+	testutils.TestAccPreCheck(t)
+	name := testutils.RandomName("tf-test-team")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamConfigWithSettings(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("localskills_team.test", "default_visibility", "private"),
+					resource.TestCheckResourceAttr("localskills_team.test", "allowed_visibilities.#", "2"),
+					resource.TestCheckResourceAttr("localskills_team.test", "allow_public_skills", "false"),
+					resource.TestCheckTypeSetElemAttr("localskills_team.test", "allowed_email_domains.*", "example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestTeamResource_identityRoundTrip(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/tenants", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "tenant-1", "name": "Team One", "slug": "team-one", "role": "owner"},
+		})
+	})
+
+	state, identity := testutils.ImportByIdentity(t, team.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "tenant-1"),
+	})
+
+	testutils.CheckStringAttribute(t, identity.GetAttribute, "id", "tenant-1")
+
+	testutils.CheckStringAttribute(t, state.GetAttribute, "id", "tenant-1")
+	testutils.CheckStringAttribute(t, state.GetAttribute, "slug", "team-one")
+}
+
+func TestTeamResource_settings(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	var patched map[string]interface{}
+	mux.HandleFunc("/api/tenants/tenant-1", func(w http.ResponseWriter, r *http.Request) {
+		tenant := map[string]interface{}{
+			"id": "tenant-1", "name": "Team One", "slug": "team-one", "role": "owner",
+			"defaultVisibility": "public", "allowedVisibilities": []string{"public", "private", "unlisted"},
+			"allowPublicSkills": true, "allowedEmailDomains": []string{"example.com"},
+		}
+		if r.Method == http.MethodPatch {
+			json.NewDecoder(r.Body).Decode(&patched)
+			for k, v := range patched {
+				tenant[k] = v
+			}
+		}
+		testutils.RespondData(w, tenant)
+	})
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	state, _ := testutils.ImportByIdentity(t, team.NewResource(), c, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "tenant-1"),
+	})
+	testutils.CheckStringAttribute(t, state.GetAttribute, "default_visibility", "public")
+
+	state, diags := testutils.Update(t, team.NewResource(), c, state, map[string]tftypes.Value{
+		"default_visibility":    tftypes.NewValue(tftypes.String, "private"),
+		"allowed_email_domains": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{}),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+
+	// Only the changed settings are sent
+	if len(patched) != 2 || patched["defaultVisibility"] != "private" {
+		t.Errorf("unexpected update request: %v", patched)
+	}
+	if domains, ok := patched["allowedEmailDomains"].([]interface{}); !ok || len(domains) != 0 {
+		t.Errorf("expected an empty allowedEmailDomains list, got %v", patched["allowedEmailDomains"])
+	}
+	testutils.CheckStringAttribute(t, state.GetAttribute, "default_visibility", "private")
+}
+
+func TestTeamResource_validateSettings(t *testing.T) {
+	visibilities := func(values ...string) tftypes.Value {
+		elems := make([]tftypes.Value, len(values))
+		for i, v := range values {
+			elems[i] = tftypes.NewValue(tftypes.String, v)
+		}
+		return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elems)
+	}
+
+	cases := map[string]struct {
+		attrs   map[string]tftypes.Value
+		wantErr bool
+	}{
+		"default in allowed": {
+			attrs: map[string]tftypes.Value{
+				"default_visibility":   tftypes.NewValue(tftypes.String, "private"),
+				"allowed_visibilities": visibilities("private", "unlisted"),
+			},
+		},
+		"default not in allowed": {
+			attrs: map[string]tftypes.Value{
+				"default_visibility":   tftypes.NewValue(tftypes.String, "public"),
+				"allowed_visibilities": visibilities("private"),
+			},
+			wantErr: true,
+		},
+		"public default without public skills": {
+			attrs: map[string]tftypes.Value{
+				"default_visibility":  tftypes.NewValue(tftypes.String, "public"),
+				"allow_public_skills": tftypes.NewValue(tftypes.Bool, false),
+			},
+			wantErr: true,
+		},
+		"public allowed without public skills": {
+			attrs: map[string]tftypes.Value{
+				"allowed_visibilities": visibilities("public", "private"),
+				"allow_public_skills":  tftypes.NewValue(tftypes.Bool, false),
+			},
+			wantErr: true,
+		},
+		"private only without public skills": {
+			attrs: map[string]tftypes.Value{
+				"default_visibility":   tftypes.NewValue(tftypes.String, "private"),
+				"allowed_visibilities": visibilities("private"),
+				"allow_public_skills":  tftypes.NewValue(tftypes.Bool, false),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.attrs["name"] = tftypes.NewValue(tftypes.String, "Team One")
+			diags := testutils.ValidateConfig(t, team.NewResource(), nil, tc.attrs)
+			if diags.HasError() != tc.wantErr {
+				t.Errorf("expected error %v, got %s", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestTeamResource_adoptExisting(t *testing.T) {
+	cases := map[string]struct {
+		tenants   []map[string]interface{}
+		wantID    string
+		wantError string
+	}{
+		"adopts the team with the conflicting slug": {
+			tenants: []map[string]interface{}{
+				{"id": "tenant-1", "name": "Platform Team", "slug": "platform-team-2", "role": "owner"},
+				{"id": "tenant-2", "name": "Old Name", "slug": "platform-team", "role": "admin"},
+			},
+			wantID: "tenant-2",
+		},
+		"ignores a team that only shares the name": {
+			tenants: []map[string]interface{}{
+				{"id": "tenant-1", "name": "Platform Team", "slug": "platform-team-2", "role": "owner"},
+			},
+			wantError: "Error creating team",
+		},
+		"rejects a team the caller cannot manage": {
+			tenants: []map[string]interface{}{
+				{"id": "tenant-2", "name": "Platform Team", "slug": "platform-team", "role": "member"},
+			},
+			wantError: "Cannot Adopt Existing Team",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server, mux := testutils.NewMockLocalskillsServer()
+			defer server.Close()
+
+			mux.HandleFunc("/api/tenants", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					testutils.RespondError(w, http.StatusConflict, "a team with this slug already exists")
+					return
+				}
+				testutils.RespondData(w, tc.tenants)
+			})
+			var updated []string
+			mux.HandleFunc("/api/tenants/", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch {
+					t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+					return
+				}
+				var body map[string]interface{}
+				json.NewDecoder(r.Body).Decode(&body)
+				if _, ok := body["slug"]; ok {
+					t.Errorf("expected the adopted team to keep its slug, got %v", body)
+				}
+				id := strings.TrimPrefix(r.URL.Path, "/api/tenants/")
+				updated = append(updated, id)
+				testutils.RespondData(w, map[string]interface{}{"id": id, "name": "Platform Team", "slug": "platform-team"})
+			})
+
+			state, diags := testutils.Create(t, team.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+				"name":                tftypes.NewValue(tftypes.String, "Platform Team"),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, true),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, false),
+				"on_destroy":          tftypes.NewValue(tftypes.String, "delete"),
+				"force_destroy":       tftypes.NewValue(tftypes.Bool, false),
+			})
+
+			if tc.wantError != "" {
+				if !diags.HasError() || diags.Errors()[0].Summary() != tc.wantError {
+					t.Fatalf("expected the error %q, got %s", tc.wantError, diags)
+				}
+				if len(updated) != 0 {
+					t.Fatalf("expected no team to be updated, got %v", updated)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %s", diags)
+			}
+			if len(diags.Warnings()) != 1 || diags.Warnings()[0].Summary() != "Adopted Existing Team" {
+				t.Fatalf("expected an adoption warning, got %s", diags)
+			}
+			if len(updated) != 1 || updated[0] != tc.wantID {
+				t.Fatalf("expected %s to be renamed, got %v", tc.wantID, updated)
+			}
+			testutils.CheckStringAttribute(t, state.GetAttribute, "id", tc.wantID)
+			testutils.CheckStringAttribute(t, state.GetAttribute, "name", "Platform Team")
+		})
+	}
+}
+
+// newTeamDeleteServer serves tenant-1 and, if withContents is set, one skill,
+// team token and OIDC trust policy owned by it. Every DELETE request path is
+// appended to deletes.
+func newTeamDeleteServer(t *testing.T, withContents bool, deletes *[]string) *httptest.Server {
+	t.Helper()
+	server, mux := testutils.NewMockLocalskillsServer()
+
+	list := func(path string, items []map[string]interface{}) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if !withContents {
+				items = []map[string]interface{}{}
+			}
+			testutils.RespondData(w, items)
+		})
+	}
+	list("/api/skills", []map[string]interface{}{{"id": "skill-1", "tenantId": "tenant-1", "name": "Skill"}})
+	list("/api/tenants/tenant-1/tokens", []map[string]interface{}{{"id": "tok-1", "name": "ci"}})
+	list("/api/tenants/tenant-1/oidc-policies", []map[string]interface{}{{"id": "pol-1", "name": "deploy"}})
+
+	for _, path := range []string{"/api/tenants/tenant-1", "/api/skills/skill-1", "/api/tenants/tenant-1/tokens/tok-1", "/api/tenants/tenant-1/oidc-policies/pol-1"} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet && r.URL.Path == "/api/tenants/tenant-1" {
+				testutils.RespondData(w, map[string]interface{}{"id": "tenant-1", "name": "Team One", "slug": "team-one", "role": "owner"})
+				return
+			}
+			if r.Method != http.MethodDelete {
+				t.Errorf("expected DELETE for %s, got %s", r.URL.Path, r.Method)
+			}
+			*deletes = append(*deletes, r.URL.Path)
+			testutils.RespondData(w, nil)
+		})
+	}
+
+	return server
+}
+
+func importTeamForDelete(t *testing.T, c *client.Client, attrs map[string]interface{}) tfsdk.State {
+	t.Helper()
+	state, _ := testutils.ImportByIdentity(t, team.NewResource(), c, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "tenant-1"),
+	})
+	for name, value := range attrs {
+		if diags := state.SetAttribute(context.Background(), path.Root(name), value); diags.HasError() {
+			t.Fatalf("unexpected errors setting %s: %s", name, diags)
+		}
+	}
+	return state
+}
+
+func TestTeamResource_delete(t *testing.T) {
+	var deletes []string
+	server := newTeamDeleteServer(t, false, &deletes)
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	state := importTeamForDelete(t, c, nil)
+	testutils.CheckStringAttribute(t, state.GetAttribute, "on_destroy", "delete")
+
+	if diags := testutils.Delete(t, team.NewResource(), c, state); diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+	if len(deletes) != 1 || deletes[0] != "/api/tenants/tenant-1" {
+		t.Errorf("expected only the team to be deleted, got %v", deletes)
+	}
+}
+
+func TestTeamResource_deleteNotEmpty(t *testing.T) {
+	var deletes []string
+	server := newTeamDeleteServer(t, true, &deletes)
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	state := importTeamForDelete(t, c, nil)
+
+	if diags := testutils.Delete(t, team.NewResource(), c, state); !diags.HasError() {
+		t.Fatal("expected an error deleting a team that still owns resources")
+	}
+	if len(deletes) != 0 {
+		t.Errorf("expected nothing to be deleted, got %v", deletes)
+	}
+}
+
+func TestTeamResource_forceDestroy(t *testing.T) {
+	var deletes []string
+	server := newTeamDeleteServer(t, true, &deletes)
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	state := importTeamForDelete(t, c, map[string]interface{}{"force_destroy": true})
+
+	if diags := testutils.Delete(t, team.NewResource(), c, state); diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+
+	want := []string{
+		"/api/tenants/tenant-1/oidc-policies/pol-1",
+		"/api/tenants/tenant-1/tokens/tok-1",
+		"/api/skills/skill-1",
+		"/api/tenants/tenant-1",
+	}
+	if strings.Join(deletes, ",") != strings.Join(want, ",") {
+		t.Errorf("expected deletes %v, got %v", want, deletes)
+	}
+}
+
+func TestTeamResource_onDestroy(t *testing.T) {
+	var deletes []string
+	server := newTeamDeleteServer(t, false, &deletes)
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "lsk_test123")
+
+	state := importTeamForDelete(t, c, map[string]interface{}{"on_destroy": "abandon"})
+	diags := testutils.Delete(t, team.NewResource(), c, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+	if diags.WarningsCount() != 1 {
+		t.Fatalf("expected 1 warning abandoning the team, got %d", diags.WarningsCount())
+	}
+
+	state = importTeamForDelete(t, c, map[string]interface{}{"on_destroy": "error"})
+	if diags := testutils.Delete(t, team.NewResource(), c, state); !diags.HasError() {
+		t.Fatal("expected an error with on_destroy = \"error\"")
+	}
+
+	if len(deletes) != 0 {
+		t.Errorf("expected nothing to be deleted, got %v", deletes)
+	}
+}
+
+func TestTeamResource_deletionProtection(t *testing.T) {
+	var deletes []string
+	server := newTeamDeleteServer(t, true, &deletes)
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "lsk_test123")
+
+	state := importTeamForDelete(t, c, map[string]interface{}{"deletion_protection": true, "force_destroy": true})
+	if diags := testutils.Delete(t, team.NewResource(), c, state); !diags.HasError() {
+		t.Fatal("expected an error deleting a protected team")
+	}
+	if len(deletes) != 0 {
+		t.Fatalf("expected nothing to be deleted, got %v", deletes)
+	}
+
+	state = importTeamForDelete(t, c, map[string]interface{}{"deletion_protection": false, "force_destroy": true})
+	if diags := testutils.Delete(t, team.NewResource(), c, state); diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+	if len(deletes) == 0 || deletes[len(deletes)-1] != "/api/tenants/tenant-1" {
+		t.Fatalf("expected the team to be deleted, got %v", deletes)
+	}
+}
+
+func testAccTeamImportStateSlugFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["slug"], nil
+	}
+}
+
 func testAccTeamConfig(name string) string {
 	return `
 resource "localskills_team" "test" {
@@ -74,3 +488,15 @@ resource "localskills_team" "test" {
 }
 `
 }
+
+func testAccTeamConfigWithSettings(name string) string {
+	return `
+resource "localskills_team" "test" {
+  name                  = "` + name + `"
+  default_visibility    = "private"
+  allowed_visibilities  = ["private", "unlisted"]
+  allow_public_skills   = false
+  allowed_email_domains = ["example.com"]
+}
+`
+}
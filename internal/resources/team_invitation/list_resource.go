@@ -0,0 +1,97 @@
+package team_invitation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+)
+
+var (
+	_ list.ListResource              = &TeamInvitationListResource{}
+	_ list.ListResourceWithConfigure = &TeamInvitationListResource{}
+)
+
+type TeamInvitationListResource struct {
+	client *client.Client
+}
+
+type TeamInvitationListModel struct {
+	TenantID types.String `tfsdk:"tenant_id"`
+}
+
+func NewListResource() list.ListResource {
+	return &TeamInvitationListResource{}
+}
+
+func (r *TeamInvitationListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_invitation"
+}
+
+func (r *TeamInvitationListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists invitations of a team so they can be discovered with `terraform query` and imported.",
+		Attributes: map[string]schema.Attribute{
+			"tenant_id": schema.StringAttribute{
+				Description: "The tenant (team) ID to list invitations for.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *TeamInvitationListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+func (r *TeamInvitationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config TeamInvitationListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	invitations, err := r.client.ListInvitations(ctx, config.TenantID.ValueString())
+	if err != nil {
+		diags.AddError("Error listing team invitations", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i := range invitations {
+			result := req.NewListResult(ctx)
+			result.DisplayName = invitations[i].Email
+			result.Diagnostics.Append(result.Identity.Set(ctx, TeamInvitationIdentityModel{TenantID: config.TenantID, ID: types.StringValue(invitations[i].ID)})...)
+
+			if req.IncludeResource {
+				var state TeamInvitationModel
+				mapInvitationToState(&invitations[i], &state)
+				state.TenantID = config.TenantID
+				state.OnDestroy = types.StringValue("revoke")
+				state.RenewWhenExpired = types.BoolValue(true)
+				result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package team_invitation_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/resources/team_invitation"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
+)
+
+func TestTeamInvitationListResource_list(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/tenants/tenant-1/invitations", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "inv-1", "tenantId": "tenant-1", "email": "dev@example.com", "role": "member", "expiresAt": "2024-01-08T00:00:00Z"},
+		})
+	})
+
+	results := testutils.ListAll(t, team_invitation.NewListResource(), team_invitation.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+	}, false)
+
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	if results[0].DisplayName != "dev@example.com" {
+		t.Errorf("expected display name 'dev@example.com', got '%s'", results[0].DisplayName)
+	}
+
+	testutils.CheckStringAttribute(t, results[0].Identity.GetAttribute, "tenant_id", "tenant-1")
+	testutils.CheckStringAttribute(t, results[0].Identity.GetAttribute, "id", "inv-1")
+	if !results[0].Resource.Raw.IsNull() {
+		t.Error("expected no resource data when include_resource is false")
+	}
+}
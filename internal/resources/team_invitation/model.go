@@ -5,13 +5,21 @@ import (
 )
 
 type TeamInvitationModel struct {
-	ID         types.String `tfsdk:"id"`
-	TenantID   types.String `tfsdk:"tenant_id"`
-	Email      types.String `tfsdk:"email"`
-	Role       types.String `tfsdk:"role"`
-	Token      types.String `tfsdk:"token"`
-	InvitedBy  types.String `tfsdk:"invited_by"`
-	ExpiresAt  types.String `tfsdk:"expires_at"`
-	AcceptedAt types.String `tfsdk:"accepted_at"`
-	CreatedAt  types.String `tfsdk:"created_at"`
+	ID               types.String `tfsdk:"id"`
+	TenantID         types.String `tfsdk:"tenant_id"`
+	Email            types.String `tfsdk:"email"`
+	Role             types.String `tfsdk:"role"`
+	Token            types.String `tfsdk:"token"`
+	InvitedBy        types.String `tfsdk:"invited_by"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+	AcceptedAt       types.String `tfsdk:"accepted_at"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	OnDestroy        types.String `tfsdk:"on_destroy"`
+	ResendTrigger    types.String `tfsdk:"resend_trigger"`
+	RenewWhenExpired types.Bool   `tfsdk:"renew_when_expired"`
+}
+
+type TeamInvitationIdentityModel struct {
+	TenantID types.String `tfsdk:"tenant_id"`
+	ID       types.String `tfsdk:"id"`
 }
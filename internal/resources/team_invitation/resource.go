@@ -4,23 +4,30 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/providerdata"
 )
 
 var (
 	_ resource.Resource                = &TeamInvitationResource{}
 	_ resource.ResourceWithConfigure   = &TeamInvitationResource{}
 	_ resource.ResourceWithImportState = &TeamInvitationResource{}
+	_ resource.ResourceWithIdentity    = &TeamInvitationResource{}
+	_ resource.ResourceWithModifyPlan  = &TeamInvitationResource{}
 )
 
 type TeamInvitationResource struct {
@@ -37,7 +44,7 @@ func (r *TeamInvitationResource) Metadata(_ context.Context, req resource.Metada
 
 func (r *TeamInvitationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a team invitation on localskills.sh. Invitations are **immutable** — all changes require replacement.\n\n~> **Note:** Deleting this resource only removes it from Terraform state. The invitation is **not** revoked via the API.",
+		MarkdownDescription: "Manages a team invitation on localskills.sh. Invitations are **immutable** — changing `tenant_id`, `email` or `role` requires replacement. Changing `resend_trigger` emails the invitation again. An invitation that expires before it is accepted is replaced unless `renew_when_expired` is false, and an accepted invitation is no longer refreshed.\n\n~> **Note:** Destroying this resource revokes the invitation if it has not been accepted yet. Set `on_destroy` to `abandon` to leave it pending, or to `error` to refuse the destroy.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The unique identifier of the invitation.",
@@ -103,6 +110,40 @@ func (r *TeamInvitationResource) Schema(_ context.Context, _ resource.SchemaRequ
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"on_destroy": schema.StringAttribute{
+				Description: "What to do when the invitation is destroyed. 'revoke' cancels it if it has not been accepted; 'abandon' removes it from Terraform state and leaves it pending until it expires; 'error' fails the destroy. Defaults to 'revoke'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("revoke"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("revoke", "abandon", "error"),
+				},
+			},
+			"renew_when_expired": schema.BoolAttribute{
+				Description: "Whether to plan a replacement of the invitation once it has expired without being accepted. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"resend_trigger": schema.StringAttribute{
+				Description: "An arbitrary value that, when changed, re-sends the invitation email without replacing the resource. Re-sending issues a new token and extends the expiry.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *TeamInvitationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"tenant_id": identityschema.StringAttribute{
+				Description:       "The ID of the team (tenant) the invitation belongs to.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the invitation.",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
@@ -112,16 +153,58 @@ func (r *TeamInvitationResource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerdata.ResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ResourceData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.Client
+}
+
+func (r *TeamInvitationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state TeamInvitationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.AcceptedAt.IsNull() && invitationExpired(state.ExpiresAt.ValueString(), time.Now()) {
+		if !plan.RenewWhenExpired.ValueBool() {
+			resp.Diagnostics.AddWarning(
+				"Team Invitation Expired",
+				fmt.Sprintf("The invitation for %s expired at %s without being accepted. Set renew_when_expired = true or change resend_trigger to invite them again.", state.Email.ValueString(), state.ExpiresAt.ValueString()),
+			)
+		} else {
+			// Terraform only replaces a resource whose plan differs from state,
+			// so the attributes the new invitation gets are marked unknown
+			plan.ID = types.StringUnknown()
+			plan.Token = types.StringUnknown()
+			plan.InvitedBy = types.StringUnknown()
+			plan.ExpiresAt = types.StringUnknown()
+			plan.CreatedAt = types.StringUnknown()
+			plan.AcceptedAt = types.StringUnknown()
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+			resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+			return
+		}
+	}
+
+	// Re-sending issues a new token and expiry, so they are only known after apply
+	if !plan.ResendTrigger.Equal(state.ResendTrigger) && state.AcceptedAt.IsNull() {
+		plan.Token = types.StringUnknown()
+		plan.ExpiresAt = types.StringUnknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	}
 }
 
 func (r *TeamInvitationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -140,21 +223,11 @@ func (r *TeamInvitationResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	plan.ID = types.StringValue(invitation.ID)
+	mapInvitationToState(invitation, &plan)
 	plan.TenantID = types.StringValue(invitation.TenantID)
-	plan.Email = types.StringValue(invitation.Email)
-	plan.Role = types.StringValue(invitation.Role)
-	plan.Token = types.StringValue(invitation.Token)
-	plan.InvitedBy = types.StringValue(invitation.InvitedBy)
-	plan.ExpiresAt = types.StringValue(invitation.ExpiresAt)
-	plan.CreatedAt = types.StringValue(invitation.CreatedAt)
-	if invitation.AcceptedAt != nil {
-		plan.AcceptedAt = types.StringValue(*invitation.AcceptedAt)
-	} else {
-		plan.AcceptedAt = types.StringNull()
-	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamInvitationIdentityModel{TenantID: plan.TenantID, ID: plan.ID})...)
 }
 
 func (r *TeamInvitationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -164,10 +237,30 @@ func (r *TeamInvitationResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	invitations, err := r.client.ListInvitations(ctx, state.TenantID.ValueString())
+	if state.OnDestroy.IsNull() {
+		state.OnDestroy = types.StringValue("revoke")
+	}
+	if state.RenewWhenExpired.IsNull() {
+		state.RenewWhenExpired = types.BoolValue(true)
+	}
+
+	// Once accepted the invitation has served its purpose and the API may
+	// drop it, so it is kept in state as-is instead of being refreshed
+	if !state.AcceptedAt.IsNull() {
+		tflog.Debug(ctx, "Team invitation already accepted, not refreshing", map[string]interface{}{
+			"id":        state.ID.ValueString(),
+			"tenant_id": state.TenantID.ValueString(),
+		})
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamInvitationIdentityModel{TenantID: state.TenantID, ID: state.ID})...)
+		return
+	}
+
+	invitation, err := r.client.GetInvitation(ctx, state.TenantID.ValueString(), state.ID.ValueString())
 	if err != nil {
 		if client.IsNotFound(err) {
-			tflog.Warn(ctx, "Team not found, removing invitation from state", map[string]interface{}{
+			tflog.Warn(ctx, "Team invitation not found, removing from state", map[string]interface{}{
+				"id":        state.ID.ValueString(),
 				"tenant_id": state.TenantID.ValueString(),
 			})
 			resp.State.RemoveResource(ctx)
@@ -177,42 +270,56 @@ func (r *TeamInvitationResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	var found bool
-	for _, inv := range invitations {
-		if inv.ID == state.ID.ValueString() {
-			state.Email = types.StringValue(inv.Email)
-			state.Role = types.StringValue(inv.Role)
-			state.Token = types.StringValue(inv.Token)
-			state.InvitedBy = types.StringValue(inv.InvitedBy)
-			state.ExpiresAt = types.StringValue(inv.ExpiresAt)
-			state.CreatedAt = types.StringValue(inv.CreatedAt)
-			if inv.AcceptedAt != nil {
-				state.AcceptedAt = types.StringValue(*inv.AcceptedAt)
-			} else {
-				state.AcceptedAt = types.StringNull()
-			}
-			found = true
-			break
-		}
-	}
+	mapInvitationToState(invitation, &state)
 
-	if !found {
-		tflog.Warn(ctx, "Team invitation not found, removing from state", map[string]interface{}{
-			"id": state.ID.ValueString(),
+	if state.AcceptedAt.IsNull() && invitationExpired(state.ExpiresAt.ValueString(), time.Now()) {
+		tflog.Info(ctx, "Team invitation has expired", map[string]interface{}{
+			"id":         state.ID.ValueString(),
+			"expires_at": state.ExpiresAt.ValueString(),
 		})
-		resp.State.RemoveResource(ctx)
-		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamInvitationIdentityModel{TenantID: state.TenantID, ID: state.ID})...)
 }
 
 func (r *TeamInvitationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// All user-settable fields use RequiresReplace, so Update should never be called.
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"Team invitations are immutable. All changes require replacement.",
-	)
+	// All API-backed fields use RequiresReplace, so only on_destroy and
+	// resend_trigger can change here.
+	var plan TeamInvitationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state TeamInvitationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ResendTrigger.Equal(state.ResendTrigger) {
+		if state.AcceptedAt.IsNull() {
+			invitation, err := r.client.ResendInvitation(ctx, state.TenantID.ValueString(), state.ID.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Error resending team invitation", err.Error())
+				return
+			}
+			mapInvitationToState(invitation, &state)
+		} else {
+			tflog.Info(ctx, "Team invitation already accepted, not resending", map[string]interface{}{
+				"id":        state.ID.ValueString(),
+				"tenant_id": state.TenantID.ValueString(),
+			})
+		}
+	}
+
+	state.OnDestroy = plan.OnDestroy
+	state.ResendTrigger = plan.ResendTrigger
+	state.RenewWhenExpired = plan.RenewWhenExpired
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamInvitationIdentityModel{TenantID: state.TenantID, ID: state.ID})...)
 }
 
 func (r *TeamInvitationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -222,14 +329,47 @@ func (r *TeamInvitationResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	tflog.Warn(ctx, "Team invitation deletion is not supported by the API. Removing invitation from Terraform state only.", map[string]interface{}{
-		"id":        state.ID.ValueString(),
-		"tenant_id": state.TenantID.ValueString(),
-		"email":     state.Email.ValueString(),
-	})
+	switch state.OnDestroy.ValueString() {
+	case "error":
+		resp.Diagnostics.AddError(
+			"Team Invitation Not Destroyed",
+			fmt.Sprintf("The invitation for %s has on_destroy = \"error\". Set on_destroy to \"revoke\" or \"abandon\" and apply before destroying it.", state.Email.ValueString()),
+		)
+		return
+	case "abandon":
+		resp.Diagnostics.AddWarning(
+			"Team Invitation Abandoned",
+			fmt.Sprintf("The invitation for %s was removed from Terraform state but remains pending on localskills.sh until it expires at %s.", state.Email.ValueString(), state.ExpiresAt.ValueString()),
+		)
+		return
+	}
+
+	// An accepted invitation has nothing left to revoke
+	if !state.AcceptedAt.IsNull() {
+		return
+	}
+
+	err := r.client.RevokeInvitation(ctx, state.TenantID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error revoking team invitation", err.Error())
+	}
 }
 
 func (r *TeamInvitationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity TeamInvitationIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tenant_id"), identity.TenantID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+		return
+	}
+
 	parts := strings.SplitN(req.ID, "/", 2)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		resp.Diagnostics.AddError(
@@ -242,3 +382,25 @@ func (r *TeamInvitationResource) ImportState(ctx context.Context, req resource.I
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tenant_id"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
 }
+
+// invitationExpired reports whether expiresAt, an RFC 3339 timestamp, is at
+// or before now. Timestamps that cannot be parsed are treated as not expired.
+func invitationExpired(expiresAt string, now time.Time) bool {
+	t, err := time.Parse(time.RFC3339, expiresAt)
+	return err == nil && !t.After(now)
+}
+
+func mapInvitationToState(inv *client.TenantInvitation, state *TeamInvitationModel) {
+	state.ID = types.StringValue(inv.ID)
+	state.Email = types.StringValue(inv.Email)
+	state.Role = types.StringValue(inv.Role)
+	state.Token = types.StringValue(inv.Token)
+	state.InvitedBy = types.StringValue(inv.InvitedBy)
+	state.ExpiresAt = types.StringValue(inv.ExpiresAt)
+	state.CreatedAt = types.StringValue(inv.CreatedAt)
+	if inv.AcceptedAt != nil {
+		state.AcceptedAt = types.StringValue(*inv.AcceptedAt)
+	} else {
+		state.AcceptedAt = types.StringNull()
+	}
+}
@@ -1,11 +1,20 @@
 package team_invitation_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/resources/team_invitation"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
 )
 
@@ -40,6 +49,210 @@ func TestAccTeamInvitationResource_basic(t *testing.T) {
 	})
 }
 
+func TestTeamInvitationResource_identityRoundTrip(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/tenants/tenant-1/invitations", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "inv-1", "tenantId": "tenant-1", "email": "user@example.com", "role": "member"},
+		})
+	})
+
+	state, identity := testutils.ImportByIdentity(t, team_invitation.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+		"id":        tftypes.NewValue(tftypes.String, "inv-1"),
+	})
+
+	testutils.CheckStringAttribute(t, identity.GetAttribute, "tenant_id", "tenant-1")
+	testutils.CheckStringAttribute(t, identity.GetAttribute, "id", "inv-1")
+
+	testutils.CheckStringAttribute(t, state.GetAttribute, "id", "inv-1")
+	testutils.CheckStringAttribute(t, state.GetAttribute, "email", "user@example.com")
+}
+
+// newInvitationServer serves a single invitation and counts revocations
+// and resends of it.
+func newInvitationServer(t *testing.T, acceptedAt interface{}, revoked, resent *int) *httptest.Server {
+	t.Helper()
+	server, mux := testutils.NewMockLocalskillsServer()
+
+	invitation := map[string]interface{}{
+		"id": "inv-1", "tenantId": "tenant-1", "email": "user@example.com", "role": "member",
+		"token": "tok-1", "expiresAt": "2024-01-08T00:00:00Z", "acceptedAt": acceptedAt,
+	}
+
+	mux.HandleFunc("/api/tenants/tenant-1/invitations/inv-1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			*revoked++
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		testutils.RespondData(w, invitation)
+	})
+	mux.HandleFunc("/api/tenants/tenant-1/invitations/inv-1/resend", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		*resent++
+		resentInvitation := map[string]interface{}{}
+		for k, v := range invitation {
+			resentInvitation[k] = v
+		}
+		resentInvitation["token"] = "tok-2"
+		resentInvitation["expiresAt"] = "2024-01-15T00:00:00Z"
+		testutils.RespondData(w, resentInvitation)
+	})
+	return server
+}
+
+func importInvitation(t *testing.T, c *client.Client) tfsdk.State {
+	t.Helper()
+	state, _ := testutils.ImportByIdentity(t, team_invitation.NewResource(), c, map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+		"id":        tftypes.NewValue(tftypes.String, "inv-1"),
+	})
+	return state
+}
+
+func TestTeamInvitationResource_onDestroy(t *testing.T) {
+	var revoked, resent int
+	server := newInvitationServer(t, nil, &revoked, &resent)
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	state := importInvitation(t, c)
+	testutils.CheckStringAttribute(t, state.GetAttribute, "on_destroy", "revoke")
+
+	if diags := testutils.Delete(t, team_invitation.NewResource(), c, state); diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+	if revoked != 1 {
+		t.Fatalf("expected the invitation to be revoked once, got %d", revoked)
+	}
+
+	state.SetAttribute(context.Background(), path.Root("on_destroy"), "abandon")
+	diags := testutils.Delete(t, team_invitation.NewResource(), c, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+	if diags.WarningsCount() != 1 {
+		t.Fatalf("expected 1 warning abandoning the invitation, got %d", diags.WarningsCount())
+	}
+
+	state.SetAttribute(context.Background(), path.Root("on_destroy"), "error")
+	diags = testutils.Delete(t, team_invitation.NewResource(), c, state)
+	if !diags.HasError() {
+		t.Fatal("expected an error with on_destroy = \"error\"")
+	}
+	if revoked != 1 {
+		t.Errorf("expected no further revocations, got %d", revoked)
+	}
+}
+
+func TestTeamInvitationResource_deleteAccepted(t *testing.T) {
+	var revoked, resent int
+	server := newInvitationServer(t, "2024-01-02T00:00:00Z", &revoked, &resent)
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	if diags := testutils.Delete(t, team_invitation.NewResource(), c, importInvitation(t, c)); diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+	if revoked != 0 {
+		t.Errorf("expected an accepted invitation not to be revoked, got %d revocations", revoked)
+	}
+}
+
+func TestTeamInvitationResource_resendTrigger(t *testing.T) {
+	var revoked, resent int
+	server := newInvitationServer(t, nil, &revoked, &resent)
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	state, diags := testutils.Update(t, team_invitation.NewResource(), c, importInvitation(t, c), map[string]tftypes.Value{
+		"resend_trigger": tftypes.NewValue(tftypes.String, "1"),
+		"token":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"expires_at":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+	if resent != 1 {
+		t.Fatalf("expected the invitation to be resent once, got %d", resent)
+	}
+	testutils.CheckStringAttribute(t, state.GetAttribute, "id", "inv-1")
+	testutils.CheckStringAttribute(t, state.GetAttribute, "expires_at", "2024-01-15T00:00:00Z")
+	testutils.CheckStringAttribute(t, state.GetAttribute, "resend_trigger", "1")
+
+	// Changing only on_destroy must not resend
+	state, diags = testutils.Update(t, team_invitation.NewResource(), c, state, map[string]tftypes.Value{
+		"on_destroy": tftypes.NewValue(tftypes.String, "abandon"),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+	if resent != 1 {
+		t.Errorf("expected no further resends, got %d", resent-1)
+	}
+	testutils.CheckStringAttribute(t, state.GetAttribute, "on_destroy", "abandon")
+}
+
+func TestTeamInvitationResource_renewWhenExpired(t *testing.T) {
+	var revoked, resent int
+	server := newInvitationServer(t, nil, &revoked, &resent)
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	state := importInvitation(t, c)
+
+	// The mock invitation expired on 2024-01-08
+	resp := testutils.ModifyPlan(t, team_invitation.NewResource(), c, state, nil)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %s", resp.Diagnostics)
+	}
+	if len(resp.RequiresReplace) != 1 || !resp.RequiresReplace[0].Equal(path.Root("expires_at")) {
+		t.Fatalf("expected a replacement forced by expires_at, got %v", resp.RequiresReplace)
+	}
+	var expiresAt types.String
+	resp.Plan.GetAttribute(context.Background(), path.Root("expires_at"), &expiresAt)
+	if !expiresAt.IsUnknown() {
+		t.Errorf("expected expires_at to be unknown in the plan, got %s", expiresAt)
+	}
+
+	resp = testutils.ModifyPlan(t, team_invitation.NewResource(), c, state, map[string]tftypes.Value{
+		"renew_when_expired": tftypes.NewValue(tftypes.Bool, false),
+	})
+	if len(resp.RequiresReplace) != 0 {
+		t.Errorf("expected no replacement with renew_when_expired = false, got %v", resp.RequiresReplace)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("expected 1 warning about the expired invitation, got %d", resp.Diagnostics.WarningsCount())
+	}
+}
+
+func TestTeamInvitationResource_acceptedNotRefreshed(t *testing.T) {
+	var revoked, resent int
+	server := newInvitationServer(t, "2024-01-02T00:00:00Z", &revoked, &resent)
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	state := importInvitation(t, c)
+	testutils.CheckStringAttribute(t, state.GetAttribute, "accepted_at", "2024-01-02T00:00:00Z")
+
+	// With the API gone, refreshing only succeeds if it is skipped
+	server.Close()
+	state, diags := testutils.Read(t, team_invitation.NewResource(), c, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+	testutils.CheckStringAttribute(t, state.GetAttribute, "id", "inv-1")
+
+	resp := testutils.ModifyPlan(t, team_invitation.NewResource(), c, state, nil)
+	if len(resp.RequiresReplace) != 0 {
+		t.Errorf("expected an accepted invitation not to be replaced, got %v", resp.RequiresReplace)
+	}
+}
+
 func testAccTeamInvitationImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
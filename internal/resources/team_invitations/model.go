@@ -0,0 +1,18 @@
+package team_invitations
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TeamInvitationsModel struct {
+	ID            types.String `tfsdk:"id"`
+	TenantID      types.String `tfsdk:"tenant_id"`
+	Invitations   types.Map    `tfsdk:"invitations"`
+	OnDestroy     types.String `tfsdk:"on_destroy"`
+	InvitationIDs types.Map    `tfsdk:"invitation_ids"`
+	Status        types.Map    `tfsdk:"status"`
+}
+
+type TeamInvitationsIdentityModel struct {
+	TenantID types.String `tfsdk:"tenant_id"`
+}
@@ -0,0 +1,427 @@
+package team_invitations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/providerdata"
+)
+
+var (
+	_ resource.Resource                = &TeamInvitationsResource{}
+	_ resource.ResourceWithImportState = &TeamInvitationsResource{}
+	_ resource.ResourceWithIdentity    = &TeamInvitationsResource{}
+)
+
+// Invitation statuses reported in the status attribute.
+const (
+	statusPending  = "pending"
+	statusAccepted = "accepted"
+	statusExpired  = "expired"
+)
+
+type TeamInvitationsResource struct {
+	client *client.Client
+}
+
+func NewResource() resource.Resource {
+	return &TeamInvitationsResource{}
+}
+
+func (r *TeamInvitationsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_invitations"
+}
+
+func (r *TeamInvitationsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a set of team invitations on localskills.sh from a single map of email to role. Each refresh lists the team's invitations once, however many emails are managed.\n\n~> **Note:** Removing an email revokes its invitation if it has not been accepted yet. Expired invitations are sent again on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the team (tenant).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tenant_id": schema.StringAttribute{
+				Description: "The ID of the team (tenant) to invite to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"invitations": schema.MapAttribute{
+				Description: "A map of email address to the role to invite them as. Roles must be one of: owner, admin, member, viewonly.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf("owner", "admin", "member", "viewonly")),
+				},
+			},
+			"on_destroy": schema.StringAttribute{
+				Description: "What to do with the invitations when this resource is destroyed. 'revoke' cancels those not yet accepted; 'abandon' removes them from Terraform state and leaves them pending until they expire; 'error' fails the destroy. Defaults to 'revoke'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("revoke"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("revoke", "abandon", "error"),
+				},
+			},
+			"invitation_ids": schema.MapAttribute{
+				Description: "A map of email address to the ID of its invitation.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"status": schema.MapAttribute{
+				Description: "A map of email address to the status of its invitation: pending, accepted, or expired.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *TeamInvitationsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"tenant_id": identityschema.StringAttribute{
+				Description:       "The ID of the team (tenant).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *TeamInvitationsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerdata.ResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.ResourceData, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = data.Client
+}
+
+func (r *TeamInvitationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TeamInvitationsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, map[string]string{}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamInvitationsIdentityModel{TenantID: plan.TenantID})...)
+}
+
+func (r *TeamInvitationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TeamInvitationsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tenantID := state.TenantID.ValueString()
+	invitations, err := r.client.ListInvitations(ctx, tenantID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Team not found, removing invitations from state", map[string]interface{}{
+				"tenant_id": tenantID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading team invitations", err.Error())
+		return
+	}
+
+	roles := map[string]string{}
+	ids := map[string]string{}
+	status := map[string]string{}
+	now := time.Now()
+
+	if state.Invitations.IsNull() {
+		// Imported: adopt every outstanding invitation
+		for i := range invitations {
+			inv := &invitations[i]
+			if invitationStatus(inv, now) != statusPending {
+				continue
+			}
+			roles[inv.Email] = inv.Role
+			ids[inv.Email] = inv.ID
+			status[inv.Email] = statusPending
+		}
+	} else {
+		priorRoles := mapFromState(ctx, state.Invitations, &resp.Diagnostics)
+		priorIDs := mapFromState(ctx, state.InvitationIDs, &resp.Diagnostics)
+		priorStatus := mapFromState(ctx, state.Status, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for email, role := range priorRoles {
+			inv := findInvitation(invitations, priorIDs[email], email)
+			if inv == nil {
+				// The API may drop invitations once accepted; those stay
+				// tracked, anything else is invited again on the next apply
+				if priorStatus[email] == statusAccepted {
+					roles[email] = role
+					ids[email] = priorIDs[email]
+					status[email] = statusAccepted
+				}
+				continue
+			}
+
+			s := invitationStatus(inv, now)
+			ids[email] = inv.ID
+			status[email] = s
+			switch s {
+			case statusAccepted:
+				roles[email] = role
+			case statusPending:
+				roles[email] = inv.Role
+			}
+			// Expired invitations are left out of invitations so the next
+			// plan sends them again
+		}
+	}
+
+	state.ID = state.TenantID
+	if state.OnDestroy.IsNull() {
+		state.OnDestroy = types.StringValue("revoke")
+	}
+	state.Invitations = mapToState(ctx, roles, &resp.Diagnostics)
+	state.InvitationIDs = mapToState(ctx, ids, &resp.Diagnostics)
+	state.Status = mapToState(ctx, status, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamInvitationsIdentityModel{TenantID: state.TenantID})...)
+}
+
+func (r *TeamInvitationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TeamInvitationsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state TeamInvitationsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	priorIDs := mapFromState(ctx, state.InvitationIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, priorIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamInvitationsIdentityModel{TenantID: plan.TenantID})...)
+}
+
+func (r *TeamInvitationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TeamInvitationsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch state.OnDestroy.ValueString() {
+	case "error":
+		resp.Diagnostics.AddError(
+			"Team Invitations Not Destroyed",
+			fmt.Sprintf("The invitations for team %s have on_destroy = \"error\". Set on_destroy to \"revoke\" or \"abandon\" and apply before destroying them.", state.TenantID.ValueString()),
+		)
+		return
+	case "abandon":
+		resp.Diagnostics.AddWarning(
+			"Team Invitations Abandoned",
+			fmt.Sprintf("The invitations for team %s were removed from Terraform state but remain pending on localskills.sh until they expire.", state.TenantID.ValueString()),
+		)
+		return
+	}
+
+	ids := mapFromState(ctx, state.InvitationIDs, &resp.Diagnostics)
+	status := mapFromState(ctx, state.Status, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for email, id := range ids {
+		if status[email] == statusAccepted {
+			continue
+		}
+		r.revoke(ctx, state.TenantID.ValueString(), email, id, &resp.Diagnostics)
+	}
+}
+
+func (r *TeamInvitationsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("tenant_id"), path.Root("tenant_id"), req, resp)
+}
+
+// apply makes the team's invitations match plan in one pass over
+// ListInvitations. priorIDs maps each email previously managed by this
+// resource to its invitation ID. Invitations for emails no longer in plan
+// are revoked, and invitations that are missing, expired, or for a different
+// role are sent again.
+func (r *TeamInvitationsResource) apply(ctx context.Context, plan *TeamInvitationsModel, priorIDs map[string]string, diags *diag.Diagnostics) {
+	tenantID := plan.TenantID.ValueString()
+
+	desired := mapFromState(ctx, plan.Invitations, diags)
+	if diags.HasError() {
+		return
+	}
+
+	invitations, err := r.client.ListInvitations(ctx, tenantID)
+	if err != nil {
+		diags.AddError("Error reading team invitations", err.Error())
+		return
+	}
+
+	now := time.Now()
+	for email, id := range priorIDs {
+		if _, ok := lookupEmail(desired, email); ok {
+			continue
+		}
+		if inv := findInvitation(invitations, id, email); inv != nil && invitationStatus(inv, now) == statusPending {
+			r.revoke(ctx, tenantID, email, inv.ID, diags)
+		}
+	}
+
+	ids := map[string]string{}
+	status := map[string]string{}
+	for email, role := range desired {
+		priorID, _ := lookupEmail(priorIDs, email)
+		inv := findInvitation(invitations, priorID, email)
+
+		if inv != nil {
+			s := invitationStatus(inv, now)
+			if s == statusAccepted || (s == statusPending && inv.Role == role) {
+				ids[email] = inv.ID
+				status[email] = s
+				continue
+			}
+			if s == statusPending {
+				// Invitations are immutable, so a role change means a new one
+				r.revoke(ctx, tenantID, email, inv.ID, diags)
+			}
+		}
+
+		tflog.Debug(ctx, "Inviting team member", map[string]interface{}{
+			"email": email,
+			"role":  role,
+		})
+		created, err := r.client.CreateInvitation(ctx, tenantID, client.CreateInvitationRequest{Email: email, Role: role})
+		if err != nil {
+			diags.AddError("Error creating team invitation", fmt.Sprintf("Could not invite %s: %s", email, err))
+			continue
+		}
+		ids[email] = created.ID
+		status[email] = statusPending
+	}
+
+	plan.ID = plan.TenantID
+	plan.InvitationIDs = mapToState(ctx, ids, diags)
+	plan.Status = mapToState(ctx, status, diags)
+}
+
+func (r *TeamInvitationsResource) revoke(ctx context.Context, tenantID, email, invitationID string, diags *diag.Diagnostics) {
+	tflog.Debug(ctx, "Revoking team invitation", map[string]interface{}{
+		"email": email,
+		"id":    invitationID,
+	})
+	err := r.client.RevokeInvitation(ctx, tenantID, invitationID)
+	if err != nil && !client.IsNotFound(err) {
+		diags.AddError("Error revoking team invitation", fmt.Sprintf("Could not revoke the invitation for %s: %s", email, err))
+	}
+}
+
+// findInvitation returns the invitation with ID id, or when id is empty or
+// no longer listed, the most recently created invitation for email.
+func findInvitation(invitations []client.TenantInvitation, id, email string) *client.TenantInvitation {
+	var found *client.TenantInvitation
+	for i := range invitations {
+		if id != "" && invitations[i].ID == id {
+			return &invitations[i]
+		}
+		if strings.EqualFold(invitations[i].Email, email) && (found == nil || invitations[i].CreatedAt > found.CreatedAt) {
+			found = &invitations[i]
+		}
+	}
+	return found
+}
+
+// invitationStatus reports whether inv is accepted, expired as of now, or
+// still pending. Expiry timestamps that cannot be parsed count as pending.
+func invitationStatus(inv *client.TenantInvitation, now time.Time) string {
+	if inv.AcceptedAt != nil {
+		return statusAccepted
+	}
+	if t, err := time.Parse(time.RFC3339, inv.ExpiresAt); err == nil && !t.After(now) {
+		return statusExpired
+	}
+	return statusPending
+}
+
+// lookupEmail returns the value for email in m, matching keys
+// case-insensitively.
+func lookupEmail(m map[string]string, email string) (string, bool) {
+	if v, ok := m[email]; ok {
+		return v, true
+	}
+	for k, v := range m {
+		if strings.EqualFold(k, email) {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+func mapFromState(ctx context.Context, m types.Map, diags *diag.Diagnostics) map[string]string {
+	values := map[string]string{}
+	if m.IsNull() || m.IsUnknown() {
+		return values
+	}
+	diags.Append(m.ElementsAs(ctx, &values, false)...)
+	return values
+}
+
+func mapToState(ctx context.Context, values map[string]string, diags *diag.Diagnostics) types.Map {
+	m, d := types.MapValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+	return m
+}
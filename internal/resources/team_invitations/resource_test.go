@@ -0,0 +1,258 @@
+package team_invitations_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/resources/team_invitations"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
+)
+
+func TestAccTeamInvitationsResource_basic(t *testing.T) {
+	testutils.TestAccPreCheck(t)
+	teamName := testutils.RandomName("tf-test-team")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamInvitationsConfig(teamName, `"tf-acc-a@example.com" = "member"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("localskills_team_invitations.test", "status.tf-acc-a@example.com", "pending"),
+					resource.TestCheckResourceAttrSet("localskills_team_invitations.test", "invitation_ids.tf-acc-a@example.com"),
+				),
+			},
+			{
+				Config: testAccTeamInvitationsConfig(teamName, `"tf-acc-a@example.com" = "admin"
+    "tf-acc-b@example.com" = "viewonly"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("localskills_team_invitations.test", "invitations.%", "2"),
+					resource.TestCheckResourceAttr("localskills_team_invitations.test", "status.tf-acc-b@example.com", "pending"),
+				),
+			},
+		},
+	})
+}
+
+// newInvitationsServer serves the given invitations for tenant-1 and records
+// every invitation created or revoked.
+func newInvitationsServer(t *testing.T, invitations []map[string]interface{}, writes *[]string) *httptest.Server {
+	t.Helper()
+	server, mux := testutils.NewMockLocalskillsServer()
+	var mu sync.Mutex
+	record := func(write string) {
+		mu.Lock()
+		defer mu.Unlock()
+		*writes = append(*writes, write)
+	}
+
+	mux.HandleFunc("/api/tenants/tenant-1/invitations", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			var body client.CreateInvitationRequest
+			json.NewDecoder(r.Body).Decode(&body)
+			record("invite " + body.Email + " " + body.Role)
+			testutils.RespondData(w, map[string]interface{}{"id": "new-" + body.Email, "email": body.Email, "role": body.Role})
+			return
+		}
+		testutils.RespondData(w, invitations)
+	})
+	mux.HandleFunc("/api/tenants/tenant-1/invitations/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		record("revoke " + strings.TrimPrefix(r.URL.Path, "/api/tenants/tenant-1/invitations/"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return server
+}
+
+func existingInvitations() []map[string]interface{} {
+	future := time.Now().Add(24 * time.Hour).Format(time.RFC3339)
+	past := time.Now().Add(-24 * time.Hour).Format(time.RFC3339)
+	return []map[string]interface{}{
+		{"id": "inv-a", "email": "a@example.com", "role": "member", "expiresAt": future},
+		{"id": "inv-b", "email": "b@example.com", "role": "viewonly", "expiresAt": future},
+		{"id": "inv-c", "email": "c@example.com", "role": "member", "expiresAt": past},
+		{"id": "inv-e", "email": "e@example.com", "role": "member", "expiresAt": past, "acceptedAt": "2024-01-02T00:00:00Z"},
+	}
+}
+
+func invitationsValue(roles map[string]string) tftypes.Value {
+	values := map[string]tftypes.Value{}
+	for email, role := range roles {
+		values[email] = tftypes.NewValue(tftypes.String, role)
+	}
+	return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, values)
+}
+
+func stringMap(t *testing.T, state tfsdk.State, name string) map[string]string {
+	t.Helper()
+	var m types.Map
+	if diags := state.GetAttribute(context.Background(), path.Root(name), &m); diags.HasError() {
+		t.Fatalf("unexpected errors reading %s: %s", name, diags)
+	}
+	values := map[string]string{}
+	m.ElementsAs(context.Background(), &values, false)
+	return values
+}
+
+func checkWrites(t *testing.T, writes []string, want ...string) {
+	t.Helper()
+	sort.Strings(writes)
+	sort.Strings(want)
+	if strings.Join(writes, "\n") != strings.Join(want, "\n") {
+		t.Errorf("unexpected writes:\n%s\nwant:\n%s", strings.Join(writes, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestTeamInvitationsResource_create(t *testing.T) {
+	var writes []string
+	server := newInvitationsServer(t, existingInvitations(), &writes)
+	defer server.Close()
+
+	state, diags := testutils.Create(t, team_invitations.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"tenant_id":  tftypes.NewValue(tftypes.String, "tenant-1"),
+		"on_destroy": tftypes.NewValue(tftypes.String, "revoke"),
+		"invitations": invitationsValue(map[string]string{
+			"a@example.com": "member",
+			"b@example.com": "admin",
+			"c@example.com": "member",
+			"d@example.com": "viewonly",
+			"e@example.com": "member",
+		}),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+
+	// a is adopted and e was already accepted; b changes role, c expired and d is new
+	checkWrites(t, writes,
+		"revoke inv-b",
+		"invite b@example.com admin",
+		"invite c@example.com member",
+		"invite d@example.com viewonly",
+	)
+
+	status := stringMap(t, state, "status")
+	if status["a@example.com"] != "pending" || status["e@example.com"] != "accepted" || status["d@example.com"] != "pending" {
+		t.Errorf("unexpected status: %v", status)
+	}
+	ids := stringMap(t, state, "invitation_ids")
+	if ids["a@example.com"] != "inv-a" || ids["b@example.com"] != "new-b@example.com" {
+		t.Errorf("unexpected invitation_ids: %v", ids)
+	}
+}
+
+func TestTeamInvitationsResource_update(t *testing.T) {
+	var writes []string
+	server := newInvitationsServer(t, existingInvitations(), &writes)
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	state, _ := testutils.ImportByIdentity(t, team_invitations.NewResource(), c, map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+	})
+
+	_, diags := testutils.Update(t, team_invitations.NewResource(), c, state, map[string]tftypes.Value{
+		"invitations":    invitationsValue(map[string]string{"b@example.com": "viewonly"}),
+		"invitation_ids": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+		"status":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+
+	checkWrites(t, writes, "revoke inv-a")
+}
+
+func TestTeamInvitationsResource_identityRoundTrip(t *testing.T) {
+	var writes []string
+	server := newInvitationsServer(t, existingInvitations(), &writes)
+	defer server.Close()
+
+	state, identity := testutils.ImportByIdentity(t, team_invitations.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+	})
+
+	testutils.CheckStringAttribute(t, identity.GetAttribute, "tenant_id", "tenant-1")
+	testutils.CheckStringAttribute(t, state.GetAttribute, "id", "tenant-1")
+	testutils.CheckStringAttribute(t, state.GetAttribute, "on_destroy", "revoke")
+
+	// Only the outstanding invitations are adopted
+	invitations := stringMap(t, state, "invitations")
+	if len(invitations) != 2 || invitations["a@example.com"] != "member" || invitations["b@example.com"] != "viewonly" {
+		t.Errorf("unexpected invitations: %v", invitations)
+	}
+	if len(writes) != 0 {
+		t.Errorf("expected no writes on import, got %v", writes)
+	}
+}
+
+func TestTeamInvitationsResource_readExpired(t *testing.T) {
+	var writes []string
+	server := newInvitationsServer(t, existingInvitations(), &writes)
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	state, diags := testutils.Create(t, team_invitations.NewResource(), c, map[string]tftypes.Value{
+		"tenant_id":  tftypes.NewValue(tftypes.String, "tenant-1"),
+		"on_destroy": tftypes.NewValue(tftypes.String, "revoke"),
+		"invitations": invitationsValue(map[string]string{
+			"a@example.com": "member",
+			"e@example.com": "member",
+		}),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+
+	// Point a at the expired invitation
+	state.SetAttribute(context.Background(), path.Root("invitation_ids"), map[string]string{
+		"a@example.com": "inv-c",
+		"e@example.com": "inv-e",
+	})
+	state, diags = testutils.Read(t, team_invitations.NewResource(), c, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+
+	invitations := stringMap(t, state, "invitations")
+	if _, ok := invitations["a@example.com"]; ok {
+		t.Errorf("expected the expired invitation to be left out of invitations, got %v", invitations)
+	}
+	if invitations["e@example.com"] != "member" {
+		t.Errorf("expected the accepted invitation to stay tracked, got %v", invitations)
+	}
+	if status := stringMap(t, state, "status"); status["a@example.com"] != "expired" {
+		t.Errorf("expected status expired, got %v", status)
+	}
+}
+
+func testAccTeamInvitationsConfig(teamName, invitations string) string {
+	return `
+resource "localskills_team" "test" {
+  name = "` + teamName + `"
+}
+
+resource "localskills_team_invitations" "test" {
+  tenant_id = localskills_team.test.id
+
+  invitations = {
+    ` + invitations + `
+  }
+}
+`
+}
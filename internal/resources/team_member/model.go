@@ -0,0 +1,20 @@
+package team_member
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TeamMemberModel struct {
+	ID       types.String `tfsdk:"id"`
+	TenantID types.String `tfsdk:"tenant_id"`
+	UserID   types.String `tfsdk:"user_id"`
+	Email    types.String `tfsdk:"email"`
+	Role     types.String `tfsdk:"role"`
+	Name     types.String `tfsdk:"name"`
+	JoinedAt types.String `tfsdk:"joined_at"`
+}
+
+type TeamMemberIdentityModel struct {
+	TenantID types.String `tfsdk:"tenant_id"`
+	UserID   types.String `tfsdk:"user_id"`
+}
@@ -0,0 +1,311 @@
+package team_member
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/providerdata"
+)
+
+var (
+	_ resource.Resource                = &TeamMemberResource{}
+	_ resource.ResourceWithImportState = &TeamMemberResource{}
+	_ resource.ResourceWithIdentity    = &TeamMemberResource{}
+)
+
+type TeamMemberResource struct {
+	client *client.Client
+}
+
+func NewResource() resource.Resource {
+	return &TeamMemberResource{}
+}
+
+func (r *TeamMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_member"
+}
+
+func (r *TeamMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the role of an existing member of a team on localskills.sh. Destroying this resource removes the user from the team.\n\n~> **Note:** The user must already be a member of the team, for example by accepting a `localskills_team_invitation`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the membership, in the format tenant_id/user_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tenant_id": schema.StringAttribute{
+				Description: "The ID of the team (tenant).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the member. Exactly one of user_id or email must be provided.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user_id"), path.MatchRoot("email")),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "The email address of the member. Exactly one of user_id or email must be provided.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "The role of the member. Must be one of: admin, member, viewonly.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("admin", "member", "viewonly"),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The display name of the member.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"joined_at": schema.StringAttribute{
+				Description: "The timestamp when the member joined the team.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamMemberResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"tenant_id": identityschema.StringAttribute{
+				Description:       "The ID of the team (tenant).",
+				RequiredForImport: true,
+			},
+			"user_id": identityschema.StringAttribute{
+				Description:       "The ID of the member.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *TeamMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerdata.ResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.ResourceData, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = data.Client
+}
+
+func (r *TeamMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TeamMemberModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member := r.findMember(ctx, plan.TenantID.ValueString(), plan.UserID.ValueString(), plan.Email.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if member == nil {
+		who := plan.Email.ValueString()
+		if who == "" {
+			who = plan.UserID.ValueString()
+		}
+		resp.Diagnostics.AddError(
+			"Team Member Not Found",
+			fmt.Sprintf("%s is not a member of team %s. Invite them with localskills_team_invitation and wait for the invitation to be accepted.", who, plan.TenantID.ValueString()),
+		)
+		return
+	}
+
+	if member.Role != plan.Role.ValueString() {
+		updated, err := r.client.UpdateMember(ctx, plan.TenantID.ValueString(), member.UserID, client.UpdateMemberRequest{
+			Role: plan.Role.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating team member", err.Error())
+			return
+		}
+		member = updated
+	}
+
+	mapMemberToState(member, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamMemberIdentityModel{TenantID: plan.TenantID, UserID: plan.UserID})...)
+}
+
+func (r *TeamMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TeamMemberModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member := r.findMember(ctx, state.TenantID.ValueString(), state.UserID.ValueString(), state.Email.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if member == nil {
+		tflog.Warn(ctx, "Team member not found, removing from state", map[string]interface{}{
+			"tenant_id": state.TenantID.ValueString(),
+			"user_id":   state.UserID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapMemberToState(member, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamMemberIdentityModel{TenantID: state.TenantID, UserID: state.UserID})...)
+}
+
+func (r *TeamMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TeamMemberModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state TeamMemberModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.client.UpdateMember(ctx, state.TenantID.ValueString(), state.UserID.ValueString(), client.UpdateMemberRequest{
+		Role: plan.Role.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating team member", err.Error())
+		return
+	}
+
+	plan.TenantID = state.TenantID
+	mapMemberToState(member, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamMemberIdentityModel{TenantID: plan.TenantID, UserID: plan.UserID})...)
+}
+
+func (r *TeamMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TeamMemberModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveMember(ctx, state.TenantID.ValueString(), state.UserID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error removing team member", err.Error())
+	}
+}
+
+func (r *TeamMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity TeamMemberIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tenant_id"), identity.TenantID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), identity.UserID)...)
+		return
+	}
+
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected import ID in the format: tenant_id/user_id or tenant_id/email",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tenant_id"), parts[0])...)
+	if strings.Contains(parts[1], "@") {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), parts[1])...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[1])...)
+	}
+}
+
+// findMember returns the member of tenantID matching userID, or email when
+// userID is empty. Emails are compared case-insensitively.
+func (r *TeamMemberResource) findMember(ctx context.Context, tenantID, userID, email string, diags *diag.Diagnostics) *client.TenantMember {
+	members, err := r.client.ListMembers(ctx, tenantID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return nil
+		}
+		diags.AddError("Error reading team members", err.Error())
+		return nil
+	}
+
+	for i := range members {
+		if userID != "" {
+			if members[i].UserID == userID {
+				return &members[i]
+			}
+			continue
+		}
+		if strings.EqualFold(members[i].Email, email) {
+			return &members[i]
+		}
+	}
+	return nil
+}
+
+func mapMemberToState(member *client.TenantMember, state *TeamMemberModel) {
+	state.ID = types.StringValue(state.TenantID.ValueString() + "/" + member.UserID)
+	state.UserID = types.StringValue(member.UserID)
+	// Keep the configured spelling of the email when only its case differs
+	if state.Email.IsNull() || state.Email.IsUnknown() || !strings.EqualFold(state.Email.ValueString(), member.Email) {
+		state.Email = types.StringValue(member.Email)
+	}
+	state.Role = types.StringValue(member.Role)
+	if member.Name != nil {
+		state.Name = types.StringValue(*member.Name)
+	} else {
+		state.Name = types.StringNull()
+	}
+	state.JoinedAt = types.StringValue(member.JoinedAt)
+}
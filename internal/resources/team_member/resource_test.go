@@ -0,0 +1,269 @@
+package team_member_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/resources/team_member"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
+)
+
+func TestAccTeamMemberResource_basic(t *testing.T) {
+	tenantID := os.Getenv("LOCALSKILLS_TENANT_ID")
+	email := os.Getenv("LOCALSKILLS_MEMBER_EMAIL")
+	if tenantID == "" || email == "" {
+		t.Skip("LOCALSKILLS_TENANT_ID and LOCALSKILLS_MEMBER_EMAIL must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamMemberConfig(tenantID, email, "viewonly"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("localskills_team_member.test", "user_id"),
+					resource.TestCheckResourceAttr("localskills_team_member.test", "email", email),
+					resource.TestCheckResourceAttr("localskills_team_member.test", "role", "viewonly"),
+				),
+			},
+			{
+				Config: testAccTeamMemberConfig(tenantID, email, "member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("localskills_team_member.test", "role", "member"),
+				),
+			},
+			{
+				ResourceName:      "localskills_team_member.test",
+				ImportState:       true,
+				ImportStateId:     tenantID + "/" + email,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestTeamMemberResource_identityRoundTrip(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/tenants/tenant-1/members", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"userId": "user-1", "email": "owner@example.com", "role": "owner"},
+			{"userId": "user-2", "email": "dev@example.com", "name": "Dev", "role": "member", "joinedAt": "2024-02-01T00:00:00Z"},
+		})
+	})
+
+	state, identity := testutils.ImportByIdentity(t, team_member.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+		"user_id":   tftypes.NewValue(tftypes.String, "user-2"),
+	})
+
+	testutils.CheckStringAttribute(t, identity.GetAttribute, "tenant_id", "tenant-1")
+	testutils.CheckStringAttribute(t, identity.GetAttribute, "user_id", "user-2")
+
+	testutils.CheckStringAttribute(t, state.GetAttribute, "id", "tenant-1/user-2")
+	testutils.CheckStringAttribute(t, state.GetAttribute, "email", "dev@example.com")
+	testutils.CheckStringAttribute(t, state.GetAttribute, "role", "member")
+}
+
+func TestTeamMemberResource_delete(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/tenants/tenant-1/members", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"userId": "user-2", "email": "dev@example.com", "role": "member"},
+		})
+	})
+	var removed bool
+	mux.HandleFunc("/api/tenants/tenant-1/members/user-2", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		removed = true
+		testutils.RespondData(w, nil)
+	})
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	state, _ := testutils.ImportByIdentity(t, team_member.NewResource(), c, map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+		"user_id":   tftypes.NewValue(tftypes.String, "user-2"),
+	})
+
+	if diags := testutils.Delete(t, team_member.NewResource(), c, state); diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+	if !removed {
+		t.Error("expected the member to be removed")
+	}
+}
+
+func TestTeamMemberResource_create(t *testing.T) {
+	cases := map[string]struct {
+		email       string
+		role        string
+		wantUpdates []string
+		wantError   string
+	}{
+		"adopts a member with a role change": {email: "Dev@Example.com", role: "admin", wantUpdates: []string{"admin"}},
+		"adopts a member with the same role": {email: "dev@example.com", role: "member"},
+		"not a member":                       {email: "new@example.com", role: "member", wantError: "Team Member Not Found"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server, mux := testutils.NewMockLocalskillsServer()
+			defer server.Close()
+
+			mux.HandleFunc("/api/tenants/tenant-1/members", func(w http.ResponseWriter, r *http.Request) {
+				testutils.RespondData(w, []map[string]interface{}{
+					{"userId": "user-1", "email": "owner@example.com", "role": "owner"},
+					{"userId": "user-2", "email": "dev@example.com", "name": "Dev", "role": "member", "joinedAt": "2024-02-01T00:00:00Z"},
+				})
+			})
+			var updates []string
+			mux.HandleFunc("/api/tenants/tenant-1/members/user-2", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch {
+					t.Errorf("expected PATCH, got %s", r.Method)
+				}
+				var body map[string]string
+				json.NewDecoder(r.Body).Decode(&body)
+				updates = append(updates, body["role"])
+				testutils.RespondData(w, map[string]interface{}{
+					"userId": "user-2", "email": "dev@example.com", "name": "Dev", "role": body["role"], "joinedAt": "2024-02-01T00:00:00Z",
+				})
+			})
+
+			state, diags := testutils.Create(t, team_member.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+				"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+				"user_id":   tftypes.NewValue(tftypes.String, nil),
+				"email":     tftypes.NewValue(tftypes.String, tc.email),
+				"role":      tftypes.NewValue(tftypes.String, tc.role),
+			})
+
+			if tc.wantError != "" {
+				if !diags.HasError() || diags.Errors()[0].Summary() != tc.wantError {
+					t.Fatalf("expected the error %q, got %s", tc.wantError, diags)
+				}
+				if len(updates) != 0 {
+					t.Fatalf("expected no role change, got %v", updates)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %s", diags)
+			}
+			if !slices.Equal(updates, tc.wantUpdates) {
+				t.Fatalf("expected role changes %v, got %v", tc.wantUpdates, updates)
+			}
+
+			testutils.CheckStringAttribute(t, state.GetAttribute, "id", "tenant-1/user-2")
+			testutils.CheckStringAttribute(t, state.GetAttribute, "user_id", "user-2")
+			testutils.CheckStringAttribute(t, state.GetAttribute, "email", tc.email)
+			testutils.CheckStringAttribute(t, state.GetAttribute, "role", tc.role)
+			testutils.CheckStringAttribute(t, state.GetAttribute, "name", "Dev")
+		})
+	}
+}
+
+func TestTeamMemberResource_update(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/tenants/tenant-1/members", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"userId": "user-2", "email": "dev@example.com", "name": "Dev", "role": "member", "joinedAt": "2024-02-01T00:00:00Z"},
+		})
+	})
+	var updates []string
+	mux.HandleFunc("/api/tenants/tenant-1/members/user-2", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected PATCH, got %s", r.Method)
+		}
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		updates = append(updates, body["role"])
+		testutils.RespondData(w, map[string]interface{}{
+			"userId": "user-2", "email": "dev@example.com", "name": "Dev", "role": body["role"], "joinedAt": "2024-02-01T00:00:00Z",
+		})
+	})
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	state, _ := testutils.ImportByIdentity(t, team_member.NewResource(), c, map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+		"user_id":   tftypes.NewValue(tftypes.String, "user-2"),
+	})
+
+	state, diags := testutils.Update(t, team_member.NewResource(), c, state, map[string]tftypes.Value{
+		"role": tftypes.NewValue(tftypes.String, "viewonly"),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+	if len(updates) != 1 || updates[0] != "viewonly" {
+		t.Fatalf("expected one role change to viewonly, got %v", updates)
+	}
+	testutils.CheckStringAttribute(t, state.GetAttribute, "role", "viewonly")
+	testutils.CheckStringAttribute(t, state.GetAttribute, "name", "Dev")
+}
+
+// TestTeamMemberResource_nameUsesState checks that a role change does not plan
+// the computed name as unknown.
+func TestTeamMemberResource_nameUsesState(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &fwresource.SchemaResponse{}
+	team_member.NewResource().Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	nameAttribute, ok := schemaResp.Schema.Attributes["name"].(schema.StringAttribute)
+	if !ok {
+		t.Fatalf("expected name to be a string attribute, got %T", schemaResp.Schema.Attributes["name"])
+	}
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for attrName, typ := range objectType.AttributeTypes {
+		values[attrName] = tftypes.NewValue(typ, nil)
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	req := planmodifier.StringRequest{
+		Path:        path.Root("name"),
+		StateValue:  types.StringValue("Dev"),
+		PlanValue:   types.StringUnknown(),
+		ConfigValue: types.StringNull(),
+		State:       state,
+	}
+	resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+	for _, m := range nameAttribute.PlanModifiers {
+		m.PlanModifyString(ctx, req, resp)
+	}
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %s", resp.Diagnostics)
+	}
+	if !resp.PlanValue.Equal(types.StringValue("Dev")) {
+		t.Errorf("expected name to be planned as \"Dev\", got %s", resp.PlanValue)
+	}
+}
+
+func testAccTeamMemberConfig(tenantID, email, role string) string {
+	return `
+resource "localskills_team_member" "test" {
+  tenant_id = "` + tenantID + `"
+  email     = "` + email + `"
+  role      = "` + role + `"
+}
+`
+}
@@ -0,0 +1,28 @@
+package team_membership
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TeamMembershipModel struct {
+	ID               types.String `tfsdk:"id"`
+	TenantID         types.String `tfsdk:"tenant_id"`
+	Members          types.Set    `tfsdk:"members"`
+	Mode             types.String `tfsdk:"mode"`
+	UnmanagedMembers types.Set    `tfsdk:"unmanaged_members"`
+}
+
+type TeamMembershipMemberModel struct {
+	Email types.String `tfsdk:"email"`
+	Role  types.String `tfsdk:"role"`
+}
+
+var memberAttrTypes = map[string]attr.Type{
+	"email": types.StringType,
+	"role":  types.StringType,
+}
+
+type TeamMembershipIdentityModel struct {
+	TenantID types.String `tfsdk:"tenant_id"`
+}
@@ -0,0 +1,424 @@
+package team_membership
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/providerdata"
+)
+
+var (
+	_ resource.Resource                = &TeamMembershipResource{}
+	_ resource.ResourceWithImportState = &TeamMembershipResource{}
+	_ resource.ResourceWithIdentity    = &TeamMembershipResource{}
+	_ resource.ResourceWithModifyPlan  = &TeamMembershipResource{}
+)
+
+type TeamMembershipResource struct {
+	client *client.Client
+}
+
+func NewResource() resource.Resource {
+	return &TeamMembershipResource{}
+}
+
+func (r *TeamMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_membership"
+}
+
+func (r *TeamMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manages the members of a team on localskills.sh. Users in `members` who are not yet in the team are invited, existing members get the configured role, and members not listed are removed (or only reported when `mode` is `report`). Team owners are never changed or removed.\n\n~> **Note:** Destroying this resource leaves the team's members in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the team (tenant).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tenant_id": schema.StringAttribute{
+				Description: "The ID of the team (tenant) whose members are managed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"members": schema.SetNestedAttribute{
+				Description: "The complete set of team members other than owners.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							Description: "The email address of the member.",
+							Required:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "The role of the member. Must be one of: admin, member, viewonly.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("admin", "member", "viewonly"),
+							},
+						},
+					},
+				},
+			},
+			"mode": schema.StringAttribute{
+				Description: "What to do with members not listed in members. 'enforce' removes them from the team; 'report' leaves them in place and lists them in unmanaged_members. Defaults to 'enforce'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("enforce"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("enforce", "report"),
+				},
+			},
+			"unmanaged_members": schema.SetAttribute{
+				Description: "Email addresses of team members, other than owners, that are not listed in members.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *TeamMembershipResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"tenant_id": identityschema.StringAttribute{
+				Description:       "The ID of the team (tenant).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *TeamMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerdata.ResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.ResourceData, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = data.Client
+}
+
+func (r *TeamMembershipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state TeamMembershipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case plan.Mode.ValueString() == "enforce":
+		// Applying removes every unmanaged member, so a non-empty set in state
+		// shows up as a diff
+		plan.UnmanagedMembers = types.SetValueMust(types.StringType, nil)
+	case plan.Members.Equal(state.Members):
+		plan.UnmanagedMembers = state.UnmanagedMembers
+		if len(state.UnmanagedMembers.Elements()) > 0 {
+			var emails []string
+			resp.Diagnostics.Append(state.UnmanagedMembers.ElementsAs(ctx, &emails, false)...)
+			resp.Diagnostics.AddWarning(
+				"Unmanaged Team Members",
+				fmt.Sprintf("Team %s has members not listed in localskills_team_membership: %s. They are left in place because mode is 'report'.", state.TenantID.ValueString(), strings.Join(emails, ", ")),
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *TeamMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TeamMembershipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamMembershipIdentityModel{TenantID: plan.TenantID})...)
+}
+
+func (r *TeamMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TeamMembershipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tenantID := state.TenantID.ValueString()
+	members, err := r.client.ListMembers(ctx, tenantID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Team not found, removing membership from state", map[string]interface{}{
+				"tenant_id": tenantID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading team members", err.Error())
+		return
+	}
+	invitations, err := r.client.ListInvitations(ctx, tenantID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading team invitations", err.Error())
+		return
+	}
+
+	byEmail := membersByEmail(members)
+	pending := pendingInvitationsByEmail(invitations, time.Now())
+
+	var entries []TeamMembershipMemberModel
+	if state.Members.IsNull() {
+		// Imported: adopt every current member
+		for _, m := range members {
+			if m.Role == "owner" {
+				continue
+			}
+			entries = append(entries, TeamMembershipMemberModel{
+				Email: types.StringValue(m.Email),
+				Role:  types.StringValue(m.Role),
+			})
+		}
+	} else {
+		var prior []TeamMembershipMemberModel
+		resp.Diagnostics.Append(state.Members.ElementsAs(ctx, &prior, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, p := range prior {
+			key := strings.ToLower(p.Email.ValueString())
+			if m, ok := byEmail[key]; ok && m.Role != "owner" {
+				entries = append(entries, TeamMembershipMemberModel{Email: p.Email, Role: types.StringValue(m.Role)})
+				continue
+			}
+			// An outstanding invitation counts as present; the role is
+			// reconciled once it has been accepted
+			if _, ok := pending[key]; ok {
+				entries = append(entries, p)
+			}
+		}
+	}
+
+	state.ID = state.TenantID
+	if state.Mode.IsNull() {
+		state.Mode = types.StringValue("enforce")
+	}
+	state.Members = membersToSet(ctx, entries, &resp.Diagnostics)
+	state.UnmanagedMembers = unmanagedMembers(ctx, members, entries, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamMembershipIdentityModel{TenantID: state.TenantID})...)
+}
+
+func (r *TeamMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TeamMembershipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamMembershipIdentityModel{TenantID: plan.TenantID})...)
+}
+
+func (r *TeamMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TeamMembershipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Removing team membership from state, members are left in place", map[string]interface{}{
+		"tenant_id": state.TenantID.ValueString(),
+	})
+}
+
+func (r *TeamMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("tenant_id"), path.Root("tenant_id"), req, resp)
+}
+
+// reconcile brings the team in line with plan: it invites listed users who
+// are neither members nor already invited, updates the role of listed
+// members, and removes or reports members that are not listed.
+func (r *TeamMembershipResource) reconcile(ctx context.Context, plan *TeamMembershipModel, diags *diag.Diagnostics) {
+	tenantID := plan.TenantID.ValueString()
+
+	var desired []TeamMembershipMemberModel
+	diags.Append(plan.Members.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	members, err := r.client.ListMembers(ctx, tenantID)
+	if err != nil {
+		diags.AddError("Error reading team members", err.Error())
+		return
+	}
+	invitations, err := r.client.ListInvitations(ctx, tenantID)
+	if err != nil {
+		diags.AddError("Error reading team invitations", err.Error())
+		return
+	}
+
+	byEmail := membersByEmail(members)
+	pending := pendingInvitationsByEmail(invitations, time.Now())
+
+	for _, d := range desired {
+		email := d.Email.ValueString()
+		role := d.Role.ValueString()
+		key := strings.ToLower(email)
+
+		if m, ok := byEmail[key]; ok {
+			if m.Role == "owner" {
+				diags.AddError(
+					"Cannot Manage Team Owner",
+					fmt.Sprintf("%s is an owner of team %s. Owners cannot be managed with localskills_team_membership; remove them from members.", email, tenantID),
+				)
+				continue
+			}
+			if m.Role != role {
+				tflog.Debug(ctx, "Updating team member role", map[string]interface{}{
+					"email": email,
+					"role":  role,
+				})
+				if _, err := r.client.UpdateMember(ctx, tenantID, m.UserID, client.UpdateMemberRequest{Role: role}); err != nil {
+					diags.AddError("Error updating team member", err.Error())
+				}
+			}
+			continue
+		}
+
+		if _, ok := pending[key]; ok {
+			continue
+		}
+
+		tflog.Debug(ctx, "Inviting team member", map[string]interface{}{
+			"email": email,
+			"role":  role,
+		})
+		if _, err := r.client.CreateInvitation(ctx, tenantID, client.CreateInvitationRequest{Email: email, Role: role}); err != nil {
+			diags.AddError("Error creating team invitation", err.Error())
+		}
+	}
+
+	listed := make(map[string]bool, len(desired))
+	for _, d := range desired {
+		listed[strings.ToLower(d.Email.ValueString())] = true
+	}
+
+	var remaining []client.TenantMember
+	for _, m := range members {
+		if m.Role == "owner" || listed[strings.ToLower(m.Email)] || plan.Mode.ValueString() == "report" {
+			remaining = append(remaining, m)
+			continue
+		}
+		tflog.Debug(ctx, "Removing unmanaged team member", map[string]interface{}{
+			"email":   m.Email,
+			"user_id": m.UserID,
+		})
+		if err := r.client.RemoveMember(ctx, tenantID, m.UserID); err != nil && !client.IsNotFound(err) {
+			diags.AddError("Error removing team member", err.Error())
+			remaining = append(remaining, m)
+		}
+	}
+
+	plan.ID = plan.TenantID
+	plan.UnmanagedMembers = unmanagedMembers(ctx, remaining, desired, diags)
+}
+
+func membersByEmail(members []client.TenantMember) map[string]client.TenantMember {
+	byEmail := make(map[string]client.TenantMember, len(members))
+	for _, m := range members {
+		byEmail[strings.ToLower(m.Email)] = m
+	}
+	return byEmail
+}
+
+// pendingInvitationsByEmail indexes invitations that have been neither
+// accepted nor expired as of now.
+func pendingInvitationsByEmail(invitations []client.TenantInvitation, now time.Time) map[string]client.TenantInvitation {
+	pending := make(map[string]client.TenantInvitation, len(invitations))
+	for _, inv := range invitations {
+		if inv.AcceptedAt != nil {
+			continue
+		}
+		if expiresAt, err := time.Parse(time.RFC3339, inv.ExpiresAt); err == nil && !expiresAt.After(now) {
+			continue
+		}
+		pending[strings.ToLower(inv.Email)] = inv
+	}
+	return pending
+}
+
+// unmanagedMembers returns the sorted emails of non-owner members that are
+// not listed in entries.
+func unmanagedMembers(ctx context.Context, members []client.TenantMember, entries []TeamMembershipMemberModel, diags *diag.Diagnostics) types.Set {
+	listed := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		listed[strings.ToLower(e.Email.ValueString())] = true
+	}
+
+	emails := []string{}
+	for _, m := range members {
+		if m.Role == "owner" || listed[strings.ToLower(m.Email)] {
+			continue
+		}
+		emails = append(emails, m.Email)
+	}
+	sort.Strings(emails)
+
+	set, d := types.SetValueFrom(ctx, types.StringType, emails)
+	diags.Append(d...)
+	return set
+}
+
+func membersToSet(ctx context.Context, entries []TeamMembershipMemberModel, diags *diag.Diagnostics) types.Set {
+	if entries == nil {
+		entries = []TeamMembershipMemberModel{}
+	}
+	set, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: memberAttrTypes}, entries)
+	diags.Append(d...)
+	return set
+}
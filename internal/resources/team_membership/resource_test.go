@@ -0,0 +1,213 @@
+package team_membership_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/resources/team_membership"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
+)
+
+func TestAccTeamMembershipResource_basic(t *testing.T) {
+	tenantID := os.Getenv("LOCALSKILLS_TENANT_ID")
+	email := os.Getenv("LOCALSKILLS_MEMBER_EMAIL")
+	if tenantID == "" || email == "" {
+		t.Skip("LOCALSKILLS_TENANT_ID and LOCALSKILLS_MEMBER_EMAIL must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamMembershipConfig(tenantID, email, "viewonly"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("localskills_team_membership.test", "id", tenantID),
+					resource.TestCheckResourceAttr("localskills_team_membership.test", "mode", "report"),
+					resource.TestCheckResourceAttr("localskills_team_membership.test", "members.#", "1"),
+				),
+			},
+			{
+				Config: testAccTeamMembershipConfig(tenantID, email, "member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("localskills_team_membership.test", "members.*", map[string]string{"role": "member"}),
+				),
+			},
+		},
+	})
+}
+
+// newMembershipServer serves a team with an owner, two members and two
+// invitations, one pending and one expired, and records every write.
+func newMembershipServer(t *testing.T, writes *[]string) *httptest.Server {
+	t.Helper()
+	server, mux := testutils.NewMockLocalskillsServer()
+	var mu sync.Mutex
+	record := func(write string) {
+		mu.Lock()
+		defer mu.Unlock()
+		*writes = append(*writes, write)
+	}
+
+	mux.HandleFunc("/api/tenants/tenant-1/members", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"userId": "user-1", "email": "owner@example.com", "role": "owner"},
+			{"userId": "user-2", "email": "dev@example.com", "role": "member"},
+			{"userId": "user-3", "email": "old@example.com", "role": "viewonly"},
+		})
+	})
+	mux.HandleFunc("/api/tenants/tenant-1/members/", func(w http.ResponseWriter, r *http.Request) {
+		record(r.Method + " " + r.URL.Path)
+		testutils.RespondData(w, map[string]interface{}{"userId": strings.TrimPrefix(r.URL.Path, "/api/tenants/tenant-1/members/"), "role": "admin"})
+	})
+	mux.HandleFunc("/api/tenants/tenant-1/invitations", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			var body client.CreateInvitationRequest
+			json.NewDecoder(r.Body).Decode(&body)
+			record(r.Method + " " + r.URL.Path)
+			record("invite " + body.Email + " " + body.Role)
+			testutils.RespondData(w, map[string]interface{}{"id": "inv-new", "email": body.Email, "role": body.Role})
+			return
+		}
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "inv-1", "email": "Pending@example.com", "role": "member", "expiresAt": time.Now().Add(24 * time.Hour).Format(time.RFC3339)},
+			{"id": "inv-2", "email": "expired@example.com", "role": "member", "expiresAt": time.Now().Add(-24 * time.Hour).Format(time.RFC3339)},
+		})
+	})
+	return server
+}
+
+func membershipAttrs(mode string) map[string]tftypes.Value {
+	memberType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"email": tftypes.String, "role": tftypes.String}}
+	member := func(email, role string) tftypes.Value {
+		return tftypes.NewValue(memberType, map[string]tftypes.Value{
+			"email": tftypes.NewValue(tftypes.String, email),
+			"role":  tftypes.NewValue(tftypes.String, role),
+		})
+	}
+	return map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+		"mode":      tftypes.NewValue(tftypes.String, mode),
+		"members": tftypes.NewValue(tftypes.Set{ElementType: memberType}, []tftypes.Value{
+			member("dev@example.com", "admin"),
+			member("pending@example.com", "member"),
+			member("expired@example.com", "member"),
+			member("new@example.com", "viewonly"),
+		}),
+	}
+}
+
+func unmanagedEmails(t *testing.T, state tfsdk.State) []string {
+	t.Helper()
+	var set types.Set
+	if diags := state.GetAttribute(context.Background(), path.Root("unmanaged_members"), &set); diags.HasError() {
+		t.Fatalf("unexpected errors reading unmanaged_members: %s", diags)
+	}
+	var emails []string
+	set.ElementsAs(context.Background(), &emails, false)
+	return emails
+}
+
+func TestTeamMembershipResource_enforce(t *testing.T) {
+	var writes []string
+	server := newMembershipServer(t, &writes)
+	defer server.Close()
+
+	state, diags := testutils.Create(t, team_membership.NewResource(), client.NewClient(server.URL, "lsk_test123"), membershipAttrs("enforce"))
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+
+	sort.Strings(writes)
+	want := []string{
+		"DELETE /api/tenants/tenant-1/members/user-3",
+		"PATCH /api/tenants/tenant-1/members/user-2",
+		"POST /api/tenants/tenant-1/invitations",
+		"POST /api/tenants/tenant-1/invitations",
+		"invite expired@example.com member",
+		"invite new@example.com viewonly",
+	}
+	if strings.Join(writes, "\n") != strings.Join(want, "\n") {
+		t.Errorf("unexpected writes:\n%s\nwant:\n%s", strings.Join(writes, "\n"), strings.Join(want, "\n"))
+	}
+
+	testutils.CheckStringAttribute(t, state.GetAttribute, "id", "tenant-1")
+	if emails := unmanagedEmails(t, state); len(emails) != 0 {
+		t.Errorf("expected no unmanaged members, got %v", emails)
+	}
+}
+
+func TestTeamMembershipResource_report(t *testing.T) {
+	var writes []string
+	server := newMembershipServer(t, &writes)
+	defer server.Close()
+
+	state, diags := testutils.Create(t, team_membership.NewResource(), client.NewClient(server.URL, "lsk_test123"), membershipAttrs("report"))
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+
+	for _, w := range writes {
+		if strings.HasPrefix(w, "DELETE") {
+			t.Errorf("expected no members to be removed in report mode, got %s", w)
+		}
+	}
+	if emails := unmanagedEmails(t, state); len(emails) != 1 || emails[0] != "old@example.com" {
+		t.Errorf("expected unmanaged members [old@example.com], got %v", emails)
+	}
+}
+
+func TestTeamMembershipResource_identityRoundTrip(t *testing.T) {
+	var writes []string
+	server := newMembershipServer(t, &writes)
+	defer server.Close()
+
+	state, identity := testutils.ImportByIdentity(t, team_membership.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+	})
+
+	testutils.CheckStringAttribute(t, identity.GetAttribute, "tenant_id", "tenant-1")
+	testutils.CheckStringAttribute(t, state.GetAttribute, "id", "tenant-1")
+	testutils.CheckStringAttribute(t, state.GetAttribute, "mode", "enforce")
+
+	var members types.Set
+	if diags := state.GetAttribute(context.Background(), path.Root("members"), &members); diags.HasError() {
+		t.Fatalf("unexpected errors reading members: %s", diags)
+	}
+	if len(members.Elements()) != 2 {
+		t.Errorf("expected the 2 non-owner members to be imported, got %d", len(members.Elements()))
+	}
+	if len(writes) != 0 {
+		t.Errorf("expected no writes on import, got %v", writes)
+	}
+}
+
+func testAccTeamMembershipConfig(tenantID, email, role string) string {
+	return `
+resource "localskills_team_membership" "test" {
+  tenant_id = "` + tenantID + `"
+  mode      = "report"
+
+  members = [
+    {
+      email = "` + email + `"
+      role  = "` + role + `"
+    },
+  ]
+}
+`
+}
@@ -0,0 +1,99 @@
+package team_token
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/tokenlifecycle"
+)
+
+var (
+	_ list.ListResource              = &TeamTokenListResource{}
+	_ list.ListResourceWithConfigure = &TeamTokenListResource{}
+)
+
+type TeamTokenListResource struct {
+	client *client.Client
+}
+
+type TeamTokenListModel struct {
+	TenantID types.String `tfsdk:"tenant_id"`
+}
+
+func NewListResource() list.ListResource {
+	return &TeamTokenListResource{}
+}
+
+func (r *TeamTokenListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_token"
+}
+
+func (r *TeamTokenListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists API tokens of a team so they can be discovered with `terraform query` and imported.",
+		Attributes: map[string]schema.Attribute{
+			"tenant_id": schema.StringAttribute{
+				Description: "The tenant (team) ID to list API tokens for.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *TeamTokenListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+func (r *TeamTokenListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config TeamTokenListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tokens, err := r.client.ListTeamTokens(ctx, config.TenantID.ValueString())
+	if err != nil {
+		diags.AddError("Error listing team tokens", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i := range tokens {
+			result := req.NewListResult(ctx)
+			result.DisplayName = tokens[i].Name
+			result.Diagnostics.Append(result.Identity.Set(ctx, TeamTokenIdentityModel{TenantID: config.TenantID, ID: types.StringValue(tokens[i].ID)})...)
+
+			if req.IncludeResource {
+				var state TeamTokenModel
+				mapTeamTokenToState(&tokens[i], &state)
+				state.TenantID = config.TenantID
+				state.ExpiresInDays = types.Int64Null()
+				state.TokenValue = types.StringNull()
+				state.Rotation = types.ObjectNull(tokenlifecycle.RotationAttrTypes)
+				result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
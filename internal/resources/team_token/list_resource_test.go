@@ -0,0 +1,39 @@
+package team_token_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/resources/team_token"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
+)
+
+func TestTeamTokenListResource_list(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/tenants/tenant-1/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "tok-1", "name": "ci", "createdAt": "2024-01-01T00:00:00Z"},
+			{"id": "tok-2", "name": "deploy", "createdAt": "2024-02-01T00:00:00Z"},
+		})
+	})
+
+	results := testutils.ListAll(t, team_token.NewListResource(), team_token.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+	}, true)
+
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if results[1].DisplayName != "deploy" {
+		t.Errorf("expected display name 'deploy', got '%s'", results[1].DisplayName)
+	}
+
+	testutils.CheckStringAttribute(t, results[1].Identity.GetAttribute, "tenant_id", "tenant-1")
+	testutils.CheckStringAttribute(t, results[1].Identity.GetAttribute, "id", "tok-2")
+	testutils.CheckStringAttribute(t, results[1].Resource.GetAttribute, "name", "deploy")
+	testutils.CheckStringAttribute(t, results[1].Resource.GetAttribute, "created_at", "2024-02-01T00:00:00Z")
+}
@@ -9,8 +9,16 @@ type TeamTokenModel struct {
 	TenantID      types.String `tfsdk:"tenant_id"`
 	Name          types.String `tfsdk:"name"`
 	ExpiresInDays types.Int64  `tfsdk:"expires_in_days"`
+	Scopes        types.Set    `tfsdk:"scopes"`
+	SkillIDs      types.Set    `tfsdk:"skill_ids"`
 	TokenValue    types.String `tfsdk:"token_value"`
 	LastUsedAt    types.String `tfsdk:"last_used_at"`
 	ExpiresAt     types.String `tfsdk:"expires_at"`
 	CreatedAt     types.String `tfsdk:"created_at"`
+	Rotation      types.Object `tfsdk:"rotation"`
+}
+
+type TeamTokenIdentityModel struct {
+	TenantID types.String `tfsdk:"tenant_id"`
+	ID       types.String `tfsdk:"id"`
 }
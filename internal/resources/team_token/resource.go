@@ -4,24 +4,40 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/providerdata"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/tokenlifecycle"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/tokenscope"
 )
 
 var (
-	_ resource.Resource                = &teamTokenResource{}
-	_ resource.ResourceWithImportState = &teamTokenResource{}
+	_ resource.Resource                   = &teamTokenResource{}
+	_ resource.ResourceWithImportState    = &teamTokenResource{}
+	_ resource.ResourceWithIdentity       = &teamTokenResource{}
+	_ resource.ResourceWithModifyPlan     = &teamTokenResource{}
+	_ resource.ResourceWithValidateConfig = &teamTokenResource{}
 )
 
+// replaceAttributes are the attributes whose changes replace the token.
+var replaceAttributes = []string{"tenant_id", "name", "expires_in_days", "scopes", "skill_ids"}
+
 type teamTokenResource struct {
-	client *client.Client
+	client      *client.Client
+	tokenPolicy tokenlifecycle.Policy
 }
 
 func NewResource() resource.Resource {
@@ -64,6 +80,30 @@ func (r *teamTokenResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					int64planmodifier.RequiresReplace(),
 				},
 			},
+			"scopes": schema.SetAttribute{
+				Description: "The scopes the token is limited to. Each must be one of: skills:read, skills:write, skills:publish, team:read, team:admin. If omitted, the token has every permission of its owner.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(client.TokenScopes...)),
+				},
+			},
+			"skill_ids": schema.SetAttribute{
+				Description: "The skills the token's skills:* scopes are restricted to. If omitted, they apply to every skill.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.AlsoRequires(path.MatchRoot("scopes")),
+				},
+			},
 			"token_value": schema.StringAttribute{
 				Description: "The secret token value. Only available after creation.",
 				Computed:    true,
@@ -87,6 +127,22 @@ func (r *teamTokenResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"rotation": tokenlifecycle.RotationAttribute(),
+		},
+	}
+}
+
+func (r *teamTokenResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"tenant_id": identityschema.StringAttribute{
+				Description:       "The tenant (team) ID.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the token.",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
@@ -95,15 +151,16 @@ func (r *teamTokenResource) Configure(_ context.Context, req resource.ConfigureR
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerdata.ResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ResourceData, got: %T", req.ProviderData),
 		)
 		return
 	}
-	r.client = c
+	r.client = data.Client
+	r.tokenPolicy = data.TokenPolicy
 }
 
 func (r *teamTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -121,6 +178,16 @@ func (r *teamTokenResource) Create(ctx context.Context, req resource.CreateReque
 		createReq.ExpiresInDays = &days
 	}
 
+	if !plan.Scopes.IsNull() && !plan.Scopes.IsUnknown() {
+		resp.Diagnostics.Append(plan.Scopes.ElementsAs(ctx, &createReq.Scopes, false)...)
+	}
+	if !plan.SkillIDs.IsNull() && !plan.SkillIDs.IsUnknown() {
+		resp.Diagnostics.Append(plan.SkillIDs.ElementsAs(ctx, &createReq.SkillIDs, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	token, err := r.client.CreateTeamToken(ctx, plan.TenantID.ValueString(), createReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating team token", err.Error())
@@ -139,6 +206,7 @@ func (r *teamTokenResource) Create(ctx context.Context, req resource.CreateReque
 	plan.LastUsedAt = types.StringNull()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamTokenIdentityModel{TenantID: plan.TenantID, ID: plan.ID})...)
 }
 
 func (r *teamTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -148,53 +216,72 @@ func (r *teamTokenResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	tokens, err := r.client.ListTeamTokens(ctx, currentState.TenantID.ValueString())
+	found, err := r.client.GetTeamToken(ctx, currentState.TenantID.ValueString(), currentState.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading team tokens", err.Error())
-		return
-	}
-
-	var found *client.TeamApiToken
-	for _, t := range tokens {
-		if t.ID == currentState.ID.ValueString() {
-			found = &t
-			break
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
 		}
-	}
-
-	if found == nil {
-		resp.State.RemoveResource(ctx)
+		resp.Diagnostics.AddError("Error reading team token", err.Error())
 		return
 	}
 
 	var state TeamTokenModel
-	state.ID = types.StringValue(found.ID)
+	mapTeamTokenToState(found, &state)
 	state.TenantID = currentState.TenantID
-	state.Name = types.StringValue(found.Name)
 	state.ExpiresInDays = currentState.ExpiresInDays
-	if found.LastUsedAt != nil {
-		state.LastUsedAt = types.StringValue(*found.LastUsedAt)
-	} else {
-		state.LastUsedAt = types.StringNull()
-	}
-	if found.ExpiresAt != nil {
-		state.ExpiresAt = types.StringValue(*found.ExpiresAt)
-	} else {
-		state.ExpiresAt = types.StringNull()
-	}
-	state.CreatedAt = types.StringValue(found.CreatedAt)
 
 	// Preserve token_value from state since API only returns hashes
 	state.TokenValue = currentState.TokenValue
+	state.Rotation = currentState.Rotation
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamTokenIdentityModel{TenantID: state.TenantID, ID: state.ID})...)
+}
+
+func (r *teamTokenResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config TeamTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(tokenscope.ValidateSkillIDs(ctx, config.Scopes, config.SkillIDs)...)
+
+	resp.Diagnostics.Append(r.tokenPolicy.ValidateLifetime(config.ExpiresInDays, types.StringNull(), time.Now())...)
 }
 
-func (r *teamTokenResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"Team tokens cannot be updated. All attributes require replacement.",
-	)
+func (r *teamTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state TeamTokenModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenlifecycle.PlanRotation(ctx, plan.Rotation, state.CreatedAt, state.ExpiresAt, req, resp)
+	r.tokenPolicy.PlanExpiry(state.Name, state.ExpiresAt, replaceAttributes, req, resp)
+}
+
+func (r *teamTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state TeamTokenModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every other attribute requires replacement, so only the rotation
+	// settings can change in place.
+	state.Rotation = plan.Rotation
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamTokenIdentityModel{TenantID: state.TenantID, ID: state.ID})...)
 }
 
 func (r *teamTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -214,6 +301,17 @@ func (r *teamTokenResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *teamTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity TeamTokenIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tenant_id"), identity.TenantID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+		return
+	}
+
 	parts := strings.SplitN(req.ID, "/", 2)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		resp.Diagnostics.AddError(
@@ -226,3 +324,21 @@ func (r *teamTokenResource) ImportState(ctx context.Context, req resource.Import
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tenant_id"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
 }
+
+func mapTeamTokenToState(token *client.TeamApiToken, state *TeamTokenModel) {
+	state.ID = types.StringValue(token.ID)
+	state.Name = types.StringValue(token.Name)
+	if token.LastUsedAt != nil {
+		state.LastUsedAt = types.StringValue(*token.LastUsedAt)
+	} else {
+		state.LastUsedAt = types.StringNull()
+	}
+	if token.ExpiresAt != nil {
+		state.ExpiresAt = types.StringValue(*token.ExpiresAt)
+	} else {
+		state.ExpiresAt = types.StringNull()
+	}
+	state.CreatedAt = types.StringValue(token.CreatedAt)
+	state.Scopes = tokenscope.SetOrNull(token.Scopes)
+	state.SkillIDs = tokenscope.SetOrNull(token.SkillIDs)
+}
@@ -1,10 +1,20 @@
 package team_token_test
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
 	"os"
+	"sort"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/resources/team_token"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
 )
 
@@ -40,6 +50,112 @@ func TestAccTeamTokenResource_basic(t *testing.T) {
 	})
 }
 
+func TestTeamTokenResource_identityRoundTrip(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/tenants/tenant-1/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "tok-1", "name": "ci", "createdAt": "2024-01-01T00:00:00Z"},
+		})
+	})
+
+	state, identity := testutils.ImportByIdentity(t, team_token.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "tenant-1"),
+		"id":        tftypes.NewValue(tftypes.String, "tok-1"),
+	})
+
+	testutils.CheckStringAttribute(t, identity.GetAttribute, "tenant_id", "tenant-1")
+	testutils.CheckStringAttribute(t, identity.GetAttribute, "id", "tok-1")
+
+	testutils.CheckStringAttribute(t, state.GetAttribute, "id", "tok-1")
+	testutils.CheckStringAttribute(t, state.GetAttribute, "name", "ci")
+}
+
+func TestTeamTokenResource_scopes(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	var created client.CreateTeamTokenRequest
+	mux.HandleFunc("/api/tenants/tenant-1/tokens", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewDecoder(r.Body).Decode(&created)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"data":    map[string]interface{}{"id": "tok-1", "name": "ci", "token": "lsk_secret", "createdAt": "2024-01-01T00:00:00Z"},
+		})
+	})
+	mux.HandleFunc("/api/tenants/tenant-1/tokens/tok-1", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, map[string]interface{}{"id": "tok-1", "name": "ci", "createdAt": "2024-01-01T00:00:00Z", "scopes": created.Scopes, "skillIds": created.SkillIDs})
+	})
+
+	c := client.NewClient(server.URL, "lsk_test123")
+	state, diags := testutils.Create(t, team_token.NewResource(), c, map[string]tftypes.Value{
+		"tenant_id":       tftypes.NewValue(tftypes.String, "tenant-1"),
+		"name":            tftypes.NewValue(tftypes.String, "ci"),
+		"expires_in_days": tftypes.NewValue(tftypes.Number, nil),
+		"scopes":          stringSet("skills:read", "skills:publish"),
+		"skill_ids":       stringSet("sk_abc123"),
+		"rotation":        tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"rotate_after_days": tftypes.Number, "rotate_before_expiry_days": tftypes.Number}}, nil),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+
+	sort.Strings(created.Scopes)
+	if strings.Join(created.Scopes, ",") != "skills:publish,skills:read" || strings.Join(created.SkillIDs, ",") != "sk_abc123" {
+		t.Errorf("unexpected create request: %+v", created)
+	}
+
+	// The scopes read back from the API match the configured ones
+	state, diags = testutils.Read(t, team_token.NewResource(), c, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+	var scopes types.Set
+	state.GetAttribute(context.Background(), path.Root("scopes"), &scopes)
+	if len(scopes.Elements()) != 2 {
+		t.Errorf("expected 2 scopes, got %s", scopes)
+	}
+}
+
+func TestTeamTokenResource_validateScopes(t *testing.T) {
+	cases := map[string]struct {
+		attrs   map[string]tftypes.Value
+		wantErr bool
+	}{
+		"unscoped": {
+			attrs: map[string]tftypes.Value{},
+		},
+		"skills restricted to skill_ids": {
+			attrs: map[string]tftypes.Value{"scopes": stringSet("skills:read"), "skill_ids": stringSet("sk_abc123")},
+		},
+		"skill_ids without a skills scope": {
+			attrs:   map[string]tftypes.Value{"scopes": stringSet("team:read"), "skill_ids": stringSet("sk_abc123")},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.attrs["tenant_id"] = tftypes.NewValue(tftypes.String, "tenant-1")
+			tc.attrs["name"] = tftypes.NewValue(tftypes.String, "ci")
+			diags := testutils.ValidateConfig(t, team_token.NewResource(), nil, tc.attrs)
+			if diags.HasError() != tc.wantErr {
+				t.Errorf("expected error %v, got %s", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+func stringSet(values ...string) tftypes.Value {
+	elements := make([]tftypes.Value, len(values))
+	for i, v := range values {
+		elements[i] = tftypes.NewValue(tftypes.String, v)
+	}
+	return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elements)
+}
+
 func testAccTeamTokenConfig(tenantID, name string) string {
 	return `
 resource "localskills_team_token" "test" {
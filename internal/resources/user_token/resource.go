@@ -3,23 +3,41 @@ package user_token
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/providerdata"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/tokenlifecycle"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/tokenscope"
 )
 
 var (
-	_ resource.Resource                = &userTokenResource{}
-	_ resource.ResourceWithImportState = &userTokenResource{}
+	_ resource.Resource                   = &userTokenResource{}
+	_ resource.ResourceWithImportState    = &userTokenResource{}
+	_ resource.ResourceWithIdentity       = &userTokenResource{}
+	_ resource.ResourceWithModifyPlan     = &userTokenResource{}
+	_ resource.ResourceWithValidateConfig = &userTokenResource{}
 )
 
+// replaceAttributes are the attributes whose changes replace the token.
+var replaceAttributes = []string{"name", "expires_in_days", "scopes", "skill_ids", "expires_at"}
+
 type userTokenResource struct {
-	client *client.Client
+	client      *client.Client
+	tokenPolicy tokenlifecycle.Policy
 }
 
 func NewResource() resource.Resource {
@@ -48,6 +66,41 @@ func (r *userTokenResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"expires_in_days": schema.Int64Attribute{
+				Description: "Number of days until the token expires. Conflicts with expires_at.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.ConflictsWith(path.MatchRoot("expires_at")),
+				},
+			},
+			"scopes": schema.SetAttribute{
+				Description: "The scopes the token is limited to. Each must be one of: skills:read, skills:write, skills:publish, team:read, team:admin. If omitted, the token has every permission of its owner.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(client.TokenScopes...)),
+				},
+			},
+			"skill_ids": schema.SetAttribute{
+				Description: "The skills the token's skills:* scopes are restricted to. If omitted, they apply to every skill.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.AlsoRequires(path.MatchRoot("scopes")),
+				},
+			},
 			"token_value": schema.StringAttribute{
 				Description: "The secret token value. Only available after creation.",
 				Computed:    true,
@@ -61,8 +114,13 @@ func (r *userTokenResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 			},
 			"expires_at": schema.StringAttribute{
-				Description: "When the token expires.",
+				Description: "When the token expires, as an RFC 3339 timestamp. Set it to expire the token at a fixed time instead of after expires_in_days.",
+				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
 			},
 			"created_at": schema.StringAttribute{
 				Description: "When the token was created.",
@@ -71,6 +129,18 @@ func (r *userTokenResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"rotation": tokenlifecycle.RotationAttribute(),
+		},
+	}
+}
+
+func (r *userTokenResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the token.",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
@@ -79,15 +149,16 @@ func (r *userTokenResource) Configure(_ context.Context, req resource.ConfigureR
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerdata.ResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ResourceData, got: %T", req.ProviderData),
 		)
 		return
 	}
-	r.client = c
+	r.client = data.Client
+	r.tokenPolicy = data.TokenPolicy
 }
 
 func (r *userTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -97,9 +168,29 @@ func (r *userTokenResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	token, err := r.client.CreateUserToken(ctx, client.CreateTokenRequest{
+	createReq := client.CreateTokenRequest{
 		Name: plan.Name.ValueString(),
-	})
+	}
+	if !plan.ExpiresInDays.IsNull() && !plan.ExpiresInDays.IsUnknown() {
+		days := int(plan.ExpiresInDays.ValueInt64())
+		createReq.ExpiresInDays = &days
+	}
+	if !plan.ExpiresAt.IsNull() && !plan.ExpiresAt.IsUnknown() {
+		expiresAt := plan.ExpiresAt.ValueString()
+		createReq.ExpiresAt = &expiresAt
+	}
+
+	if !plan.Scopes.IsNull() && !plan.Scopes.IsUnknown() {
+		resp.Diagnostics.Append(plan.Scopes.ElementsAs(ctx, &createReq.Scopes, false)...)
+	}
+	if !plan.SkillIDs.IsNull() && !plan.SkillIDs.IsUnknown() {
+		resp.Diagnostics.Append(plan.SkillIDs.ElementsAs(ctx, &createReq.SkillIDs, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.client.CreateUserToken(ctx, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating user token", err.Error())
 		return
@@ -109,8 +200,12 @@ func (r *userTokenResource) Create(ctx context.Context, req resource.CreateReque
 	plan.Name = types.StringValue(token.Name)
 	plan.TokenValue = types.StringValue(token.Token)
 	plan.LastUsedAt = types.StringNull()
-	plan.ExpiresAt = types.StringNull()
-	plan.CreatedAt = types.StringNull()
+	plan.ExpiresAt = expiresAtValue(plan.ExpiresAt, token.ExpiresAt)
+	if token.CreatedAt != "" {
+		plan.CreatedAt = types.StringValue(token.CreatedAt)
+	} else {
+		plan.CreatedAt = types.StringNull()
+	}
 
 	// Read back to populate all fields
 	tokens, err := r.client.ListUserTokens(ctx)
@@ -121,7 +216,7 @@ func (r *userTokenResource) Create(ctx context.Context, req resource.CreateReque
 					plan.LastUsedAt = types.StringValue(*t.LastUsedAt)
 				}
 				if t.ExpiresAt != nil {
-					plan.ExpiresAt = types.StringValue(*t.ExpiresAt)
+					plan.ExpiresAt = expiresAtValue(plan.ExpiresAt, t.ExpiresAt)
 				}
 				plan.CreatedAt = types.StringValue(t.CreatedAt)
 				break
@@ -130,6 +225,7 @@ func (r *userTokenResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserTokenIdentityModel{ID: plan.ID})...)
 }
 
 func (r *userTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -166,24 +262,76 @@ func (r *userTokenResource) Read(ctx context.Context, req resource.ReadRequest,
 	} else {
 		state.LastUsedAt = types.StringNull()
 	}
-	if found.ExpiresAt != nil {
-		state.ExpiresAt = types.StringValue(*found.ExpiresAt)
-	} else {
-		state.ExpiresAt = types.StringNull()
-	}
+	state.ExpiresAt = expiresAtValue(currentState.ExpiresAt, found.ExpiresAt)
 	state.CreatedAt = types.StringValue(found.CreatedAt)
+	state.ExpiresInDays = currentState.ExpiresInDays
+	state.Scopes = tokenscope.SetOrNull(found.Scopes)
+	state.SkillIDs = tokenscope.SetOrNull(found.SkillIDs)
 
 	// Preserve token_value from state since API only returns hashes
 	state.TokenValue = currentState.TokenValue
+	state.Rotation = currentState.Rotation
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserTokenIdentityModel{ID: state.ID})...)
+}
+
+func (r *userTokenResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config UserTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(tokenscope.ValidateSkillIDs(ctx, config.Scopes, config.SkillIDs)...)
+
+	if !config.ExpiresAt.IsNull() && !config.ExpiresAt.IsUnknown() {
+		if _, err := time.Parse(time.RFC3339, config.ExpiresAt.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expires_at"),
+				"Invalid Expiration Time",
+				fmt.Sprintf("expires_at must be an RFC 3339 timestamp such as 2025-01-31T00:00:00Z, got %q.", config.ExpiresAt.ValueString()),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(tokenlifecycle.ValidateRotation(ctx, config.Rotation, config.ExpiresAt)...)
+
+	resp.Diagnostics.Append(r.tokenPolicy.ValidateLifetime(config.ExpiresInDays, config.ExpiresAt, time.Now())...)
+}
+
+func (r *userTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state UserTokenModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenlifecycle.PlanRotation(ctx, plan.Rotation, state.CreatedAt, state.ExpiresAt, req, resp)
+	r.tokenPolicy.PlanExpiry(state.Name, state.ExpiresAt, replaceAttributes, req, resp)
 }
 
-func (r *userTokenResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"User tokens cannot be updated. All attributes require replacement.",
-	)
+func (r *userTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state UserTokenModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every other attribute requires replacement, so only the rotation
+	// settings can change in place.
+	state.Rotation = plan.Rotation
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserTokenIdentityModel{ID: state.ID})...)
 }
 
 func (r *userTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -203,5 +351,22 @@ func (r *userTokenResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *userTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// expiresAtValue returns the expiry reported by the API, keeping prior when
+// it names the same instant so a configured expires_at written differently
+// does not show a diff.
+func expiresAtValue(prior types.String, actual *string) types.String {
+	if actual == nil {
+		return types.StringNull()
+	}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		p, err := time.Parse(time.RFC3339, prior.ValueString())
+		a, actualErr := time.Parse(time.RFC3339, *actual)
+		if err == nil && actualErr == nil && p.Equal(a) {
+			return prior
+		}
+	}
+	return types.StringValue(*actual)
 }
@@ -1,10 +1,17 @@
 package user_token_test
 
 import (
+	"encoding/json"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/resources/user_token"
 	"github.com/localskills-sh/terraform-provider-localskills/internal/testutils"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/tokenlifecycle"
 )
 
 func TestAccUserTokenResource_basic(t *testing.T) {
@@ -33,6 +40,146 @@ func TestAccUserTokenResource_basic(t *testing.T) {
 	})
 }
 
+func TestUserTokenResource_identityRoundTrip(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/user/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testutils.RespondData(w, []map[string]interface{}{
+			{"id": "tok-1", "name": "laptop", "createdAt": "2024-01-01T00:00:00Z"},
+		})
+	})
+
+	state, identity := testutils.ImportByIdentity(t, user_token.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "tok-1"),
+	})
+
+	testutils.CheckStringAttribute(t, identity.GetAttribute, "id", "tok-1")
+
+	testutils.CheckStringAttribute(t, state.GetAttribute, "id", "tok-1")
+	testutils.CheckStringAttribute(t, state.GetAttribute, "name", "laptop")
+}
+
+func TestUserTokenResource_expiresInDays(t *testing.T) {
+	server, mux := testutils.NewMockLocalskillsServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/user/tokens", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			var body client.CreateTokenRequest
+			json.NewDecoder(r.Body).Decode(&body)
+			if body.ExpiresInDays == nil || *body.ExpiresInDays != 30 {
+				t.Errorf("expected expiresInDays 30, got %v", body.ExpiresInDays)
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"success": true,
+				"data":    map[string]interface{}{"id": "tok-1", "name": "laptop", "token": "lsk_secret", "createdAt": "2024-01-01T00:00:00Z", "expiresAt": "2024-01-31T00:00:00Z"},
+			})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"data": []map[string]interface{}{
+				{"id": "tok-1", "name": "laptop", "createdAt": "2024-01-01T00:00:00Z", "expiresAt": "2024-01-31T00:00:00.000Z"},
+			},
+		})
+	})
+
+	state, diags := testutils.Create(t, user_token.NewResource(), client.NewClient(server.URL, "lsk_test123"), map[string]tftypes.Value{
+		"name":            tftypes.NewValue(tftypes.String, "laptop"),
+		"expires_in_days": tftypes.NewValue(tftypes.Number, 30),
+		"rotation":        tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"rotate_after_days": tftypes.Number, "rotate_before_expiry_days": tftypes.Number}}, nil),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %s", diags)
+	}
+
+	testutils.CheckStringAttribute(t, state.GetAttribute, "expires_at", "2024-01-31T00:00:00Z")
+	testutils.CheckStringAttribute(t, state.GetAttribute, "created_at", "2024-01-01T00:00:00Z")
+}
+
+func TestUserTokenResource_validateConfig(t *testing.T) {
+	policy := tokenlifecycle.Policy{MaxLifetimeDays: 90}
+	inAYear := time.Now().AddDate(1, 0, 0).Format(time.RFC3339)
+	inAMonth := time.Now().AddDate(0, 1, 0).Format(time.RFC3339)
+
+	cases := map[string]struct {
+		policy  tokenlifecycle.Policy
+		attrs   map[string]tftypes.Value
+		wantErr bool
+	}{
+		"no policy": {
+			attrs: map[string]tftypes.Value{"expires_in_days": tftypes.NewValue(tftypes.Number, 365)},
+		},
+		"within policy": {
+			policy: policy,
+			attrs:  map[string]tftypes.Value{"expires_in_days": tftypes.NewValue(tftypes.Number, 90)},
+		},
+		"exceeds policy": {
+			policy:  policy,
+			attrs:   map[string]tftypes.Value{"expires_in_days": tftypes.NewValue(tftypes.Number, 365)},
+			wantErr: true,
+		},
+		"never expires": {
+			policy:  policy,
+			attrs:   map[string]tftypes.Value{},
+			wantErr: true,
+		},
+		"expires_at within policy": {
+			policy: policy,
+			attrs:  map[string]tftypes.Value{"expires_at": tftypes.NewValue(tftypes.String, inAMonth)},
+		},
+		"expires_at exceeds policy": {
+			policy:  policy,
+			attrs:   map[string]tftypes.Value{"expires_at": tftypes.NewValue(tftypes.String, inAYear)},
+			wantErr: true,
+		},
+		"skill_ids without a skills scope": {
+			attrs: map[string]tftypes.Value{
+				"scopes":    tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "team:admin")}),
+				"skill_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "sk_abc123")}),
+			},
+			wantErr: true,
+		},
+		"invalid expires_at": {
+			attrs:   map[string]tftypes.Value{"expires_at": tftypes.NewValue(tftypes.String, "next tuesday")},
+			wantErr: true,
+		},
+		"rotate_before_expiry_days with expires_at": {
+			attrs: map[string]tftypes.Value{
+				"expires_at": tftypes.NewValue(tftypes.String, inAMonth),
+				"rotation":   rotationValue(nil, 7),
+			},
+			wantErr: true,
+		},
+		"rotate_after_days with expires_at": {
+			attrs: map[string]tftypes.Value{
+				"expires_at": tftypes.NewValue(tftypes.String, inAMonth),
+				"rotation":   rotationValue(30, nil),
+			},
+		},
+		"rotate_before_expiry_days with expires_in_days": {
+			attrs: map[string]tftypes.Value{
+				"expires_in_days": tftypes.NewValue(tftypes.Number, 30),
+				"rotation":        rotationValue(nil, 7),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.attrs["name"] = tftypes.NewValue(tftypes.String, "laptop")
+			r := user_token.NewResource()
+			testutils.ConfigureResource(t, r, client.NewClient("http://localhost", "lsk_test123"), tc.policy)
+			diags := testutils.ValidateConfig(t, r, nil, tc.attrs)
+			if diags.HasError() != tc.wantErr {
+				t.Errorf("expected error %v, got %s", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
 func testAccUserTokenConfig(name string) string {
 	return `
 resource "localskills_user_token" "test" {
@@ -40,3 +187,13 @@ resource "localskills_user_token" "test" {
 }
 `
 }
+
+func rotationValue(rotateAfterDays, rotateBeforeExpiryDays interface{}) tftypes.Value {
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"rotate_after_days":         tftypes.Number,
+		"rotate_before_expiry_days": tftypes.Number,
+	}}, map[string]tftypes.Value{
+		"rotate_after_days":         tftypes.NewValue(tftypes.Number, rotateAfterDays),
+		"rotate_before_expiry_days": tftypes.NewValue(tftypes.Number, rotateBeforeExpiryDays),
+	})
+}
@@ -0,0 +1,48 @@
+// Package teamsettings maps the team-level skill and invitation policy shared
+// by the team resource and data source.
+package teamsettings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+)
+
+// Model holds the team settings attributes. The team resource and data source
+// models embed it.
+type Model struct {
+	DefaultVisibility   types.String `tfsdk:"default_visibility"`
+	AllowedVisibilities types.Set    `tfsdk:"allowed_visibilities"`
+	AllowPublicSkills   types.Bool   `tfsdk:"allow_public_skills"`
+	AllowedEmailDomains types.Set    `tfsdk:"allowed_email_domains"`
+}
+
+// Map returns settings as a Model. Settings the server omits are null, except
+// the lists: a missing list means no restriction, so it maps to an empty set.
+func Map(ctx context.Context, settings *client.TenantSettings, diags *diag.Diagnostics) Model {
+	var m Model
+	if settings.DefaultVisibility != "" {
+		m.DefaultVisibility = types.StringValue(settings.DefaultVisibility)
+	} else {
+		m.DefaultVisibility = types.StringNull()
+	}
+	if settings.AllowPublicSkills != nil {
+		m.AllowPublicSkills = types.BoolValue(*settings.AllowPublicSkills)
+	} else {
+		m.AllowPublicSkills = types.BoolNull()
+	}
+	m.AllowedVisibilities = stringSet(ctx, settings.AllowedVisibilities, diags)
+	m.AllowedEmailDomains = stringSet(ctx, settings.AllowedEmailDomains, diags)
+	return m
+}
+
+func stringSet(ctx context.Context, values []string, diags *diag.Diagnostics) types.Set {
+	if values == nil {
+		values = []string{}
+	}
+	set, d := types.SetValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+	return set
+}
@@ -0,0 +1,49 @@
+package testutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+)
+
+// ReadDataSource runs d's Read against a config built from attrs, with every
+// attribute not in attrs null, and returns the resulting state.
+func ReadDataSource(t *testing.T, d datasource.DataSource, c *client.Client, attrs map[string]tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	if dc, ok := d.(datasource.DataSourceWithConfigure); ok {
+		configureResp := &datasource.ConfigureResponse{}
+		dc.Configure(ctx, datasource.ConfigureRequest{ProviderData: c}, configureResp)
+		if configureResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected configure errors: %s", configureResp.Diagnostics)
+		}
+	}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		if v, ok := attrs[name]; ok {
+			values[name] = v
+		} else {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+	}
+	raw := tftypes.NewValue(objectType, values)
+
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: raw.Copy()},
+	}
+	d.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw},
+	}, resp)
+	return resp.State, resp.Diagnostics
+}
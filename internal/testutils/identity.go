@@ -0,0 +1,100 @@
+package testutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+)
+
+// ImportByIdentity imports r using an import block identity and refreshes it,
+// mirroring what Terraform does for `import { identity = { ... } }`. It returns
+// the refreshed state and identity so callers can assert on the round-trip.
+func ImportByIdentity(t *testing.T, r resource.Resource, c *client.Client, identity map[string]tftypes.Value) (tfsdk.State, *tfsdk.ResourceIdentity) {
+	t.Helper()
+	ctx := context.Background()
+
+	configureResource(t, r, c)
+	s := resourceSchema(r)
+
+	ri, ok := r.(resource.ResourceWithIdentity)
+	if !ok {
+		t.Fatalf("%T does not implement resource.ResourceWithIdentity", r)
+	}
+	identityResp := &resource.IdentitySchemaResponse{}
+	ri.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identityResp)
+
+	importIdentity := &tfsdk.ResourceIdentity{
+		Schema: identityResp.IdentitySchema,
+		Raw:    tftypes.NewValue(identityResp.IdentitySchema.Type().TerraformType(ctx), identity),
+	}
+
+	importResp := &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+		},
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: importIdentity.Schema,
+			Raw:    importIdentity.Raw.Copy(),
+		},
+	}
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{Identity: importIdentity}, importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected import errors: %s", importResp.Diagnostics)
+	}
+
+	readResp := &resource.ReadResponse{
+		State: importResp.State,
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: importResp.Identity.Schema,
+			Raw:    importResp.Identity.Raw.Copy(),
+		},
+	}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State, Identity: importResp.Identity}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read errors: %s", readResp.Diagnostics)
+	}
+
+	return readResp.State, readResp.Identity
+}
+
+// ImportByID runs r's ImportState for the import ID id, as `terraform import`
+// does, and returns the imported state and diagnostics without refreshing it.
+func ImportByID(t *testing.T, r resource.Resource, c *client.Client, id string) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	configureResource(t, r, c)
+	s := resourceSchema(r)
+
+	importResp := &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+		},
+		Identity: emptyIdentity(r),
+	}
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: id}, importResp)
+	return importResp.State, importResp.Diagnostics
+}
+
+// CheckStringAttribute fails the test if the string attribute name, read via
+// get (a tfsdk.State or tfsdk.ResourceIdentity GetAttribute method), is not want.
+func CheckStringAttribute(t *testing.T, get func(context.Context, path.Path, interface{}) diag.Diagnostics, name, want string) {
+	t.Helper()
+
+	var got types.String
+	if diags := get(context.Background(), path.Root(name), &got); diags.HasError() {
+		t.Fatalf("unexpected errors reading %s: %s", name, diags)
+	}
+	if got.ValueString() != want {
+		t.Errorf("expected %s '%s', got '%s'", name, want, got.ValueString())
+	}
+}
@@ -0,0 +1,63 @@
+package testutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+)
+
+// ListAll runs lr with the given list block config, mirroring what Terraform
+// does for `terraform query`, and collects every pushed result. r is the
+// managed resource lr lists, used for the resource and identity schemas.
+func ListAll(t *testing.T, lr list.ListResource, r resource.Resource, c *client.Client, config map[string]tftypes.Value, includeResource bool) []list.ListResult {
+	t.Helper()
+	ctx := context.Background()
+
+	if lc, ok := lr.(list.ListResourceWithConfigure); ok {
+		configureResp := &resource.ConfigureResponse{}
+		lc.Configure(ctx, resource.ConfigureRequest{ProviderData: c}, configureResp)
+		if configureResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected configure errors: %s", configureResp.Diagnostics)
+		}
+	}
+
+	listSchemaResp := &list.ListResourceSchemaResponse{}
+	lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, listSchemaResp)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	ri, ok := r.(resource.ResourceWithIdentity)
+	if !ok {
+		t.Fatalf("%T does not implement resource.ResourceWithIdentity", r)
+	}
+	identityResp := &resource.IdentitySchemaResponse{}
+	ri.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identityResp)
+
+	req := list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: listSchemaResp.Schema,
+			Raw:    tftypes.NewValue(listSchemaResp.Schema.Type().TerraformType(ctx), config),
+		},
+		IncludeResource:        includeResource,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identityResp.IdentitySchema,
+	}
+
+	stream := &list.ListResultsStream{}
+	lr.List(ctx, req, stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("unexpected list errors: %s", result.Diagnostics)
+		}
+		results = append(results, result)
+	}
+	return results
+}
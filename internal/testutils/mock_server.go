@@ -22,3 +22,16 @@ func NewMockLocalskillsServer() (*httptest.Server, *http.ServeMux) {
 	server := httptest.NewServer(mux)
 	return server, mux
 }
+
+// RespondData writes data as a successful API response.
+func RespondData(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{"success": true, "data": data})
+}
+
+// RespondError writes an API error response with the given status code.
+func RespondError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{"success": false, "error": message})
+}
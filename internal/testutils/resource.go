@@ -0,0 +1,252 @@
+package testutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/client"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/providerdata"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/tokenlifecycle"
+)
+
+// Create runs r.Create with a plan built from attrs, filling every attribute
+// not in attrs with an unknown value as Terraform does for computed
+// attributes, and returns the resulting state and diagnostics.
+func Create(t *testing.T, r resource.Resource, c *client.Client, attrs map[string]tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	configureResource(t, r, c)
+
+	s := resourceSchema(r)
+	plan := tfsdk.Plan{
+		Schema: s,
+		Raw:    buildObject(t, s, attrs, tftypes.UnknownValue),
+	}
+	createResp := &resource.CreateResponse{
+		State:    tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+		Identity: emptyIdentity(r),
+	}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, createResp)
+	return createResp.State, createResp.Diagnostics
+}
+
+// Read runs r.Read against state and returns the refreshed state and
+// diagnostics.
+func Read(t *testing.T, r resource.Resource, c *client.Client, state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	configureResource(t, r, c)
+
+	readResp := &resource.ReadResponse{
+		State:    tfsdk.State{Schema: state.Schema, Raw: state.Raw.Copy()},
+		Identity: emptyIdentity(r),
+	}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, readResp)
+	return readResp.State, readResp.Diagnostics
+}
+
+// Update runs r.Update with a plan that is state with attrs overridden and
+// returns the resulting state and diagnostics. Attributes set to an unknown
+// value in attrs are planned as unknown.
+func Update(t *testing.T, r resource.Resource, c *client.Client, state tfsdk.State, attrs map[string]tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	configureResource(t, r, c)
+
+	plan := tfsdk.Plan{
+		Schema: state.Schema,
+		Raw:    overrideAttributes(t, state, attrs),
+	}
+	updateResp := &resource.UpdateResponse{
+		State:    tfsdk.State{Schema: state.Schema, Raw: state.Raw.Copy()},
+		Identity: emptyIdentity(r),
+	}
+	r.Update(context.Background(), resource.UpdateRequest{Plan: plan, State: state}, updateResp)
+	return updateResp.State, updateResp.Diagnostics
+}
+
+// Delete runs r.Delete against state and returns the resulting diagnostics.
+func Delete(t *testing.T, r resource.Resource, c *client.Client, state tfsdk.State) diag.Diagnostics {
+	t.Helper()
+	configureResource(t, r, c)
+
+	deleteResp := &resource.DeleteResponse{State: state}
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, deleteResp)
+	return deleteResp.Diagnostics
+}
+
+// ModifyPlan runs r's ModifyPlan for an update from state to a plan that is
+// state with attrs overridden, and returns the response.
+func ModifyPlan(t *testing.T, r resource.Resource, c *client.Client, state tfsdk.State, attrs map[string]tftypes.Value) *resource.ModifyPlanResponse {
+	t.Helper()
+
+	plan := tfsdk.Plan{
+		Schema: state.Schema,
+		Raw:    overrideAttributes(t, state, attrs),
+	}
+	return modifyPlan(t, r, c, state, plan, attrs)
+}
+
+// ModifyPlanForCreate runs r's ModifyPlan for a create with a plan built from
+// attrs as Create does, and returns the response.
+func ModifyPlanForCreate(t *testing.T, r resource.Resource, c *client.Client, attrs map[string]tftypes.Value) *resource.ModifyPlanResponse {
+	t.Helper()
+
+	s := resourceSchema(r)
+	plan := tfsdk.Plan{
+		Schema: s,
+		Raw:    buildObject(t, s, attrs, tftypes.UnknownValue),
+	}
+	state := tfsdk.State{
+		Schema: s,
+		Raw:    tftypes.NewValue(s.Type().TerraformType(context.Background()), nil),
+	}
+	return modifyPlan(t, r, c, state, plan, attrs)
+}
+
+// ValidateConfig runs r's ValidateConfig against a config built from attrs,
+// with every attribute not in attrs null, and returns the diagnostics.
+func ValidateConfig(t *testing.T, r resource.Resource, c *client.Client, attrs map[string]tftypes.Value) diag.Diagnostics {
+	t.Helper()
+
+	rv, ok := r.(resource.ResourceWithValidateConfig)
+	if !ok {
+		t.Fatalf("%T does not implement resource.ResourceWithValidateConfig", r)
+	}
+	configureResource(t, r, c)
+
+	s := resourceSchema(r)
+	resp := &resource.ValidateConfigResponse{}
+	rv.ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: s, Raw: buildObject(t, s, attrs, nil)},
+	}, resp)
+	return resp.Diagnostics
+}
+
+func modifyPlan(t *testing.T, r resource.Resource, c *client.Client, state tfsdk.State, plan tfsdk.Plan, attrs map[string]tftypes.Value) *resource.ModifyPlanResponse {
+	t.Helper()
+
+	rm, ok := r.(resource.ResourceWithModifyPlan)
+	if !ok {
+		t.Fatalf("%T does not implement resource.ResourceWithModifyPlan", r)
+	}
+	configureResource(t, r, c)
+
+	resp := &resource.ModifyPlanResponse{
+		Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw.Copy()},
+	}
+	rm.ModifyPlan(context.Background(), resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: plan.Schema, Raw: configFromPlan(t, plan, attrs)},
+		Plan:   plan,
+		State:  state,
+	}, resp)
+	return resp
+}
+
+// ConfigureResource configures r as the provider does, with c and the token
+// lifecycle policy policy. The other helpers configure r with c and an empty
+// policy, unless c is nil, in which case r is used as it is.
+func ConfigureResource(t *testing.T, r resource.Resource, c *client.Client, policy tokenlifecycle.Policy) {
+	t.Helper()
+
+	rc, ok := r.(resource.ResourceWithConfigure)
+	if !ok {
+		return
+	}
+	configureResp := &resource.ConfigureResponse{}
+	rc.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: &providerdata.ResourceData{Client: c, TokenPolicy: policy},
+	}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure errors: %s", configureResp.Diagnostics)
+	}
+}
+
+// configureResource configures r with c unless c is nil.
+func configureResource(t *testing.T, r resource.Resource, c *client.Client) {
+	t.Helper()
+
+	if c != nil {
+		ConfigureResource(t, r, c, tokenlifecycle.Policy{})
+	}
+}
+
+func resourceSchema(r resource.Resource) schema.Schema {
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+// emptyIdentity returns a null identity for r, or nil when r has none.
+func emptyIdentity(r resource.Resource) *tfsdk.ResourceIdentity {
+	ri, ok := r.(resource.ResourceWithIdentity)
+	if !ok {
+		return nil
+	}
+	ctx := context.Background()
+	identityResp := &resource.IdentitySchemaResponse{}
+	ri.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identityResp)
+	return &tfsdk.ResourceIdentity{
+		Schema: identityResp.IdentitySchema,
+		Raw:    tftypes.NewValue(identityResp.IdentitySchema.Type().TerraformType(ctx), nil),
+	}
+}
+
+// buildObject returns an object of schema s with the values in attrs and
+// every other attribute set to missing, which is nil or tftypes.UnknownValue.
+func buildObject(t *testing.T, s schema.Schema, attrs map[string]tftypes.Value, missing interface{}) tftypes.Value {
+	t.Helper()
+
+	objectType := s.Type().TerraformType(context.Background()).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		if v, ok := attrs[name]; ok {
+			values[name] = v
+		} else {
+			values[name] = tftypes.NewValue(typ, missing)
+		}
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+// configFromPlan returns the raw config behind plan, with the computed-only
+// attributes not in attrs null.
+func configFromPlan(t *testing.T, plan tfsdk.Plan, attrs map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	planValues := map[string]tftypes.Value{}
+	if err := plan.Raw.As(&planValues); err != nil {
+		t.Fatalf("unexpected error reading plan: %s", err)
+	}
+	values := make(map[string]tftypes.Value, len(planValues))
+	for name, v := range planValues {
+		a, ok := plan.Schema.GetAttributes()[name]
+		if _, set := attrs[name]; ok && !set && a.IsComputed() && !a.IsOptional() {
+			v = tftypes.NewValue(v.Type(), nil)
+		}
+		values[name] = v
+	}
+	return tftypes.NewValue(plan.Raw.Type(), values)
+}
+
+// overrideAttributes returns the raw value of state with attrs overridden.
+func overrideAttributes(t *testing.T, state tfsdk.State, attrs map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	stateValues := map[string]tftypes.Value{}
+	if err := state.Raw.As(&stateValues); err != nil {
+		t.Fatalf("unexpected error reading state: %s", err)
+	}
+	// Copy rather than modify stateValues, which shares state.Raw's storage
+	values := make(map[string]tftypes.Value, len(stateValues))
+	for name, v := range stateValues {
+		values[name] = v
+	}
+	for name, v := range attrs {
+		values[name] = v
+	}
+	return tftypes.NewValue(state.Raw.Type(), values)
+}
@@ -0,0 +1,79 @@
+package tokenlifecycle
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// DefaultExpiryWarningDays is how close to expiry a token has to be before
+// plans warn about it when the provider leaves token_expiry_warning_days
+// unset.
+const DefaultExpiryWarningDays = 14
+
+// PlanExpiry warns when the token in state expires within the policy's
+// ExpiryWarningDays. A token that has already expired is reported as a
+// warning, or as an error when ErrorOnExpired is set and the plan would keep
+// it unchanged. Nothing is reported when the token is already being replaced,
+// either by PlanRotation or because one of replaceAttributes, the attributes
+// whose changes force a replacement, changes.
+func (p Policy) PlanExpiry(name, expiresAt types.String, replaceAttributes []string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || len(resp.RequiresReplace) > 0 {
+		return
+	}
+	// Attribute plan modifiers have already run, but the framework does not
+	// pass the paths their RequiresReplace marked into resp.RequiresReplace,
+	// so look for changes to those attributes instead
+	for _, attribute := range replaceAttributes {
+		if attributeChanged(req, attribute) {
+			return
+		}
+	}
+
+	expires, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	switch {
+	case !expires.After(now):
+		summary := "Token Expired"
+		detail := fmt.Sprintf("The token %q expired at %s and no longer works. Replace it, for example with terraform apply -replace, or add a rotation block so it is replaced automatically.", name.ValueString(), expiresAt.ValueString())
+		if p.ErrorOnExpired && req.Plan.Raw.Equal(req.State.Raw) {
+			resp.Diagnostics.AddAttributeError(path.Root("expires_at"), summary, detail)
+		} else {
+			resp.Diagnostics.AddAttributeWarning(path.Root("expires_at"), summary, detail)
+		}
+	case p.ExpiryWarningDays > 0 && !expires.After(now.AddDate(0, 0, p.ExpiryWarningDays)):
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("expires_at"),
+			"Token Expiring Soon",
+			fmt.Sprintf("The token %q expires at %s, within %d days. Replace it before then, or add a rotation block so it is replaced automatically.", name.ValueString(), expiresAt.ValueString(), p.ExpiryWarningDays),
+		)
+	}
+}
+
+// attributeChanged reports whether the top-level attribute name differs
+// between the plan and the state of req.
+func attributeChanged(req resource.ModifyPlanRequest, name string) bool {
+	attributePath := tftypes.NewAttributePath().WithAttributeName(name)
+	planned, _, err := tftypes.WalkAttributePath(req.Plan.Raw, attributePath)
+	if err != nil {
+		return false
+	}
+	prior, _, err := tftypes.WalkAttributePath(req.State.Raw, attributePath)
+	if err != nil {
+		return false
+	}
+	plannedValue, ok := planned.(tftypes.Value)
+	if !ok {
+		return false
+	}
+	priorValue, ok := prior.(tftypes.Value)
+	return ok && !plannedValue.Equal(priorValue)
+}
@@ -0,0 +1,50 @@
+package tokenlifecycle
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ValidateLifetime checks the lifetime a token asks for, either
+// expiresInDays or an absolute expiresAt, against the provider's
+// max_token_lifetime_days. With a limit set, a token that never expires is
+// rejected too. Unknown values are left for the next validation.
+func (p Policy) ValidateLifetime(expiresInDays types.Int64, expiresAt types.String, now time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+	maxDays := p.MaxLifetimeDays
+	if maxDays <= 0 || expiresInDays.IsUnknown() || expiresAt.IsUnknown() {
+		return diags
+	}
+
+	switch {
+	case !expiresInDays.IsNull():
+		if expiresInDays.ValueInt64() > int64(maxDays) {
+			diags.AddAttributeError(
+				path.Root("expires_in_days"),
+				"Token Lifetime Exceeds Policy",
+				fmt.Sprintf("expires_in_days is %d, but the provider's max_token_lifetime_days allows at most %d.", expiresInDays.ValueInt64(), maxDays),
+			)
+		}
+	case !expiresAt.IsNull():
+		// An unparseable value is reported by the resource itself
+		t, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+		if err == nil && t.After(now.AddDate(0, 0, maxDays)) {
+			diags.AddAttributeError(
+				path.Root("expires_at"),
+				"Token Lifetime Exceeds Policy",
+				fmt.Sprintf("expires_at is %s, more than the provider's max_token_lifetime_days of %d days from now.", expiresAt.ValueString(), maxDays),
+			)
+		}
+	default:
+		diags.AddAttributeError(
+			path.Root("expires_in_days"),
+			"Token Lifetime Exceeds Policy",
+			fmt.Sprintf("The provider's max_token_lifetime_days is %d, so tokens must expire. Set expires_in_days to at most %d.", maxDays, maxDays),
+		)
+	}
+	return diags
+}
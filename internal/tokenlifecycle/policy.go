@@ -0,0 +1,18 @@
+package tokenlifecycle
+
+// Policy is the token lifecycle policy set on the provider, which the token
+// resources enforce at plan time. The zero Policy, which resources have until
+// the provider is configured, enforces nothing.
+type Policy struct {
+	// MaxLifetimeDays is the longest lifetime, in days, that token resources
+	// may request. Zero means no limit.
+	MaxLifetimeDays int
+
+	// ExpiryWarningDays is how close to expiry a token resource has to be
+	// before plans warn about it. Zero disables the warning.
+	ExpiryWarningDays int
+
+	// ErrorOnExpired makes plans fail, rather than warn, when a token
+	// resource in state has already expired and would be kept as is.
+	ErrorOnExpired bool
+}
@@ -0,0 +1,137 @@
+// Package tokenlifecycle holds the rotation and expiry handling shared by the
+// token resources.
+package tokenlifecycle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RotationModel is the rotation block of a token resource.
+type RotationModel struct {
+	RotateAfterDays        types.Int64 `tfsdk:"rotate_after_days"`
+	RotateBeforeExpiryDays types.Int64 `tfsdk:"rotate_before_expiry_days"`
+}
+
+// RotationAttrTypes are the attribute types of the rotation block.
+var RotationAttrTypes = map[string]attr.Type{
+	"rotate_after_days":         types.Int64Type,
+	"rotate_before_expiry_days": types.Int64Type,
+}
+
+// computedAttributes are the attributes every token resource gets a new
+// value for when it is replaced.
+var computedAttributes = []string{"id", "token_value", "last_used_at", "expires_at", "created_at"}
+
+// RotationAttribute returns the schema of the rotation block.
+func RotationAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Replaces the token once it reaches a given age or gets close to expiring. Combine with `lifecycle { create_before_destroy = true }` so the new token exists before the old one is deleted.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"rotate_after_days": schema.Int64Attribute{
+				Description: "Replace the token once it is this many days old.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("rotate_before_expiry_days")),
+				},
+			},
+			"rotate_before_expiry_days": schema.Int64Attribute{
+				Description: "Replace the token once it expires within this many days. Has no effect on tokens that never expire, and cannot be combined with a configured expires_at, which the replacement would keep.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// ValidateRotation rejects rotate_before_expiry_days together with a
+// configured expires_at. The replacement token keeps the configured expiry,
+// so it would be due again straight away and be replaced on every apply.
+func ValidateRotation(ctx context.Context, rotation types.Object, expiresAt types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if rotation.IsNull() || rotation.IsUnknown() || expiresAt.IsNull() {
+		return diags
+	}
+
+	var model RotationModel
+	diags.Append(rotation.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() || model.RotateBeforeExpiryDays.IsNull() {
+		return diags
+	}
+	diags.AddAttributeError(
+		path.Root("rotation").AtName("rotate_before_expiry_days"),
+		"Rotation Conflicts With Fixed Expiry",
+		"rotate_before_expiry_days cannot be combined with expires_at: the replacement token would get the same expires_at and be rotated again on every apply. Use expires_in_days instead, or rotate_after_days.",
+	)
+	return diags
+}
+
+// RotationDue reports whether a token created at createdAt and expiring at
+// expiresAt is due for rotation at now. The returned path is the attribute
+// that triggers the replacement and the reason explains why.
+func RotationDue(rotation RotationModel, createdAt, expiresAt types.String, now time.Time) (path.Path, string, bool) {
+	if !rotation.RotateAfterDays.IsNull() && !rotation.RotateAfterDays.IsUnknown() {
+		if created, err := time.Parse(time.RFC3339, createdAt.ValueString()); err == nil {
+			due := created.AddDate(0, 0, int(rotation.RotateAfterDays.ValueInt64()))
+			if !due.After(now) {
+				return path.Root("created_at"), fmt.Sprintf("created at %s, more than %d days ago", createdAt.ValueString(), rotation.RotateAfterDays.ValueInt64()), true
+			}
+		}
+	}
+	if !rotation.RotateBeforeExpiryDays.IsNull() && !rotation.RotateBeforeExpiryDays.IsUnknown() {
+		if expires, err := time.Parse(time.RFC3339, expiresAt.ValueString()); err == nil {
+			due := expires.AddDate(0, 0, -int(rotation.RotateBeforeExpiryDays.ValueInt64()))
+			if !due.After(now) {
+				return path.Root("expires_at"), fmt.Sprintf("expires at %s, within %d days", expiresAt.ValueString(), rotation.RotateBeforeExpiryDays.ValueInt64()), true
+			}
+		}
+	}
+	return path.Empty(), "", false
+}
+
+// PlanRotation plans a replacement of the token in state when its rotation
+// block says it is due. It does nothing on create or destroy.
+func PlanRotation(ctx context.Context, rotation types.Object, createdAt, expiresAt types.String, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || rotation.IsNull() || rotation.IsUnknown() {
+		return
+	}
+
+	var model RotationModel
+	resp.Diagnostics.Append(rotation.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	trigger, reason, due := RotationDue(model, createdAt, expiresAt, time.Now())
+	if !due {
+		return
+	}
+
+	tflog.Info(ctx, "Token is due for rotation, planning replacement", map[string]interface{}{"reason": reason})
+	for _, name := range computedAttributes {
+		// A configured value, such as an absolute expires_at, carries over
+		var configured types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &configured)...)
+		if !configured.IsNull() {
+			continue
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), types.StringUnknown())...)
+	}
+	resp.RequiresReplace = append(resp.RequiresReplace, trigger)
+}
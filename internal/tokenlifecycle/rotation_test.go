@@ -0,0 +1,60 @@
+package tokenlifecycle_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/localskills-sh/terraform-provider-localskills/internal/tokenlifecycle"
+)
+
+func TestRotationDue(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	createdAt := types.StringValue("2024-03-01T00:00:00Z")
+	expiresAt := types.StringValue("2024-06-20T00:00:00Z")
+
+	cases := map[string]struct {
+		rotation  tokenlifecycle.RotationModel
+		expiresAt types.String
+		want      path.Path
+		due       bool
+	}{
+		"not old enough": {
+			rotation:  tokenlifecycle.RotationModel{RotateAfterDays: types.Int64Value(120), RotateBeforeExpiryDays: types.Int64Null()},
+			expiresAt: expiresAt,
+		},
+		"old enough": {
+			rotation:  tokenlifecycle.RotationModel{RotateAfterDays: types.Int64Value(90), RotateBeforeExpiryDays: types.Int64Null()},
+			expiresAt: expiresAt,
+			want:      path.Root("created_at"),
+			due:       true,
+		},
+		"not close to expiry": {
+			rotation:  tokenlifecycle.RotationModel{RotateAfterDays: types.Int64Null(), RotateBeforeExpiryDays: types.Int64Value(7)},
+			expiresAt: expiresAt,
+		},
+		"close to expiry": {
+			rotation:  tokenlifecycle.RotationModel{RotateAfterDays: types.Int64Null(), RotateBeforeExpiryDays: types.Int64Value(30)},
+			expiresAt: expiresAt,
+			want:      path.Root("expires_at"),
+			due:       true,
+		},
+		"never expires": {
+			rotation:  tokenlifecycle.RotationModel{RotateAfterDays: types.Int64Null(), RotateBeforeExpiryDays: types.Int64Value(30)},
+			expiresAt: types.StringNull(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, _, due := tokenlifecycle.RotationDue(tc.rotation, createdAt, tc.expiresAt, now)
+			if due != tc.due {
+				t.Fatalf("expected due %v, got %v", tc.due, due)
+			}
+			if due && !got.Equal(tc.want) {
+				t.Errorf("expected replacement forced by %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Package tokenscope holds the scope and skill restriction handling shared by
+// the user and team token resources and data sources.
+package tokenscope
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ValidateSkillIDs reports an error on skill_ids when it is set alongside
+// scopes that have no skills:* scope for it to restrict.
+func ValidateSkillIDs(ctx context.Context, scopes, skillIDs types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if skillIDs.IsNull() || skillIDs.IsUnknown() || scopes.IsNull() || scopes.IsUnknown() {
+		return diags
+	}
+
+	var values []string
+	diags.Append(scopes.ElementsAs(ctx, &values, false)...)
+	if !hasSkillScope(values) {
+		diags.AddAttributeError(
+			path.Root("skill_ids"),
+			"Skill IDs Without Skill Scope",
+			"skill_ids only restricts the skills:* scopes, but scopes has none. Add skills:read, skills:write or skills:publish to scopes, or remove skill_ids.",
+		)
+	}
+	return diags
+}
+
+// SetOrNull returns values as a set, or a null set when there are none so an
+// unscoped token matches a config that leaves scopes out.
+func SetOrNull(values []string) types.Set {
+	if len(values) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	return types.SetValueMust(types.StringType, stringValues(values))
+}
+
+// List returns values as a list, which is empty rather than null when there
+// are none.
+func List(values []string) types.List {
+	return types.ListValueMust(types.StringType, stringValues(values))
+}
+
+// hasSkillScope reports whether scopes includes any skills:* scope.
+func hasSkillScope(scopes []string) bool {
+	for _, scope := range scopes {
+		if strings.HasPrefix(scope, "skills:") {
+			return true
+		}
+	}
+	return false
+}
+
+func stringValues(values []string) []attr.Value {
+	elements := make([]attr.Value, len(values))
+	for i, v := range values {
+		elements[i] = types.StringValue(v)
+	}
+	return elements
+}